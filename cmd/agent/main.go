@@ -4,12 +4,30 @@ import (
 	"context"
 	"flag"
 	"fmt"
+	"log/slog"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
-	"threads-influencer/config"
-	"threads-influencer/internal"
+	"social-agent/config"
+	"social-agent/content"
+	"social-agent/internal"
+	"social-agent/internal/agent"
+	"social-agent/internal/content/source"
+	"social-agent/internal/ledger"
+	"social-agent/internal/logging"
+	"social-agent/internal/pubsub"
+	"social-agent/internal/scheduler"
+	"social-agent/internal/social"
+	"social-agent/internal/social/bluesky"
+	"social-agent/internal/social/mastodon"
+	"social-agent/internal/social/reddit"
+	"social-agent/internal/social/twitter"
+	"social-agent/internal/store"
+	"social-agent/internal/timeline"
+	"social-agent/internal/watcher"
 )
 
 func main() {
@@ -43,19 +61,33 @@ Options:
 	if *debug {
 		logLevel = "debug"
 	}
-	log := internal.NewLogger(logLevel)
+	log := logging.New(logging.Config{
+		Level:        logLevel,
+		Format:       cfg.LogFormat,
+		ModuleLevels: cfg.LogModuleLevels,
+		FilePath:     cfg.LogFilePath,
+	})
 
-	log.Info("Threads Influencer Agent starting...")
-	log.Debug("Configuration loaded. Mode: %s", map[bool]string{true: "dry-run", false: "production"}[*dryRun])
+	log.Info("Social Agent starting...")
+	log.Debug("Configuration loaded", "mode", map[bool]string{true: "dry-run", false: "production"}[*dryRun])
 
-	// Validate required configuration
-	if cfg.ThreadsAPIKey == "" || cfg.ThreadsAccessToken == "" {
-		log.Error("Threads API credentials not configured. Set THREADS_API_KEY and THREADS_ACCESS_TOKEN")
-		os.Exit(1)
+	contentCfg := content.Config{
+		Provider:    cfg.ContentProvider,
+		Model:       cfg.ContentModel,
+		APIKey:      cfg.ContentAPIKey,
+		BaseURL:     cfg.ContentBaseURL,
+		Temperature: cfg.ContentTemperature,
+		MaxTokens:   cfg.ContentMaxTokens,
+		DryRun:      cfg.ContentDryRun || *dryRun,
 	}
-
-	if cfg.GeminiAPIKey == "" {
-		log.Error("Gemini API key not configured. Set GEMINI_API_KEY")
+	if contentCfg.Provider == "" {
+		contentCfg.Provider = "gemini"
+	}
+	if contentCfg.APIKey == "" {
+		contentCfg.APIKey = cfg.GeminiAPIKey
+	}
+	if contentCfg.APIKey == "" && !contentCfg.DryRun {
+		log.Error("Content generator API key not configured. Set CONTENT_API_KEY (or GEMINI_API_KEY)")
 		os.Exit(1)
 	}
 
@@ -65,67 +97,257 @@ Options:
 		os.Exit(1)
 	}
 
-	// Initialize clients
-	redditClient := internal.NewRedditClient(cfg.RedditClientID, cfg.RedditClientSecret, cfg.RedditUsername, cfg.RedditPassword, cfg.RedditUserAgent)
+	// Content sources: Reddit is required, Twitter is registered only if a
+	// bearer token is configured.
+	redditSource, err := reddit.New(cfg.RedditClientID, cfg.RedditClientSecret, cfg.RedditUsername, cfg.RedditPassword, cfg.RedditUserAgent, cfg.RedditSubreddits)
+	if err != nil {
+		log.Error("Failed to initialize Reddit client", "error", err)
+		os.Exit(1)
+	}
 	log.Info("Reddit API client initialized")
 
-	threadsClient := internal.NewThreadsClient(cfg.ThreadsAccessToken, cfg.ThreadsAPIKey)
-	log.Info("Threads API client initialized")
+	sourceClients := map[string]source.Source{
+		"reddit": &source.RedditSource{Client: redditSource},
+	}
+	if cfg.TwitterXBearerToken != "" {
+		sourceClients["twitter"] = &source.TwitterSource{Client: twitter.New(cfg.TwitterXBearerToken)}
+		log.Info("registered content source", "source", "twitter")
+	}
+	sourceRegistry := source.NewRegistryFromConfig(cfg, sourceClients)
+
+	// Social destinations: every network with complete credentials in the
+	// environment is registered.
+	var posters []social.Poster
+	// blueskyForEngagement is the Bluesky client typed for the scheduler's
+	// engagementSource parameter (Poster + candidate-discovery methods); nil
+	// if Bluesky isn't configured, in which case the scheduler falls back to
+	// watcher-matched Reddit targeting only.
+	var blueskyForEngagement interface {
+		social.Poster
+		SearchActors(ctx context.Context, query string, limit int) ([]bluesky.Actor, error)
+		GetFollows(ctx context.Context, actor string, limit int) ([]bluesky.Actor, error)
+		GetLikes(ctx context.Context, postURI string, limit int) ([]bluesky.Actor, error)
+		SearchPosts(ctx context.Context, query string, limit int) ([]bluesky.Post, error)
+	}
+
+	if cfg.BlueskyIdentifier != "" && cfg.BlueskyAppPassword != "" {
+		bc, err := bluesky.NewWithCredentials(cfg.BlueskyIdentifier, cfg.BlueskyAppPassword)
+		if err != nil {
+			log.Error("failed to authenticate Bluesky destination", "error", err)
+		} else {
+			posters = append(posters, bc)
+			blueskyForEngagement = bc
+			log.Info("registered social destination", "destination", "bluesky", "auth", "session")
+		}
+	} else if cfg.BlueskyAccessToken != "" && cfg.BlueskyDID != "" {
+		bc := bluesky.New(cfg.BlueskyAccessToken, cfg.BlueskyDID)
+		posters = append(posters, bc)
+		blueskyForEngagement = bc
+		log.Info("registered social destination", "destination", "bluesky", "auth", "static-token")
+	}
 
-	geminiGen, err := internal.NewGeminiGenerator(cfg.GeminiAPIKey)
+	if cfg.MastodonInstanceURL != "" && cfg.MastodonAccessToken != "" {
+		posters = append(posters, mastodon.NewMastodonClient(cfg.MastodonInstanceURL, cfg.MastodonAccessToken))
+		log.Info("registered social destination", "destination", "mastodon")
+	}
+
+	if cfg.ActivityPubDomain != "" && cfg.ActivityPubUsername != "" && cfg.ActivityPubPrivateKeyPath != "" {
+		ap, err := mastodon.New(cfg.ActivityPubDomain, cfg.ActivityPubUsername, cfg.ActivityPubPrivateKeyPath)
+		if err != nil {
+			log.Error("failed to initialize ActivityPub destination", "error", err)
+		} else {
+			posters = append(posters, ap)
+			log.Info("registered social destination", "destination", "activitypub")
+		}
+	}
+
+	if cfg.ThreadsAPIKey != "" && cfg.ThreadsAccessToken != "" {
+		tc := internal.NewThreadsClient(cfg.ThreadsAccessToken, cfg.ThreadsAPIKey, internal.ThreadsClientOptions{})
+		posters = append(posters, internal.NewThreadsPoster(tc))
+		log.Info("registered social destination", "destination", "threads")
+	}
+
+	if len(posters) == 0 {
+		log.Error("No social media destinations configured. Set THREADS_API_KEY/THREADS_ACCESS_TOKEN, BLUESKY_ACCESS_TOKEN/BLUESKY_DID (or BLUESKY_IDENTIFIER/BLUESKY_APP_PASSWORD), and/or MASTODON_INSTANCE_URL/MASTODON_ACCESS_TOKEN")
+		os.Exit(1)
+	}
+
+	// Validator and post generator
+	var validator *content.Validator
+	if cfg.ContentMinChars > 0 || len(cfg.ContentBannedTerms) > 0 || cfg.ContentRequiredTone != "" {
+		var toneChecker content.ContentGenerator
+		if cfg.ContentRequiredTone != "" {
+			toneChecker, err = content.New(contentCfg)
+			if err != nil {
+				log.Error("Failed to initialize tone checker", "error", err)
+				os.Exit(1)
+			}
+		}
+		validator = content.NewValidator(content.PlatformBluesky, cfg.ContentMinChars, 0, cfg.ContentBannedTerms, toneChecker, cfg.ContentRequiredTone)
+	}
+
+	postGen, err := agent.New(contentCfg, cfg.PostContentTheme, cfg.PromptTemplatePath, validator)
 	if err != nil {
-		log.Error("Failed to initialize Gemini generator: %v", err)
+		log.Error("Failed to initialize post generator", "error", err)
 		os.Exit(1)
 	}
-	log.Info("Gemini content generator initialized")
+	log.Info("Post generator initialized", "theme", cfg.PostContentTheme)
 
-	postGen := internal.NewAgent(geminiGen, cfg.PostContentTheme)
-	log.Debug("Post generator initialized with theme: %s", cfg.PostContentTheme)
+	// Ledger, seen store, job store, watchers, timeline: each is optional
+	// (a nil dependency just disables the feature it backs), so the agent
+	// still runs against a partial configuration.
+	var postLedger *ledger.Ledger
+	if cfg.LedgerPath != "" {
+		postLedger, err = ledger.Open(cfg.LedgerPath)
+		if err != nil {
+			log.Error("Failed to open ledger", "error", err)
+			os.Exit(1)
+		}
+		defer postLedger.Close()
+	}
 
-	// Create scheduler
-	schedulerConfig := internal.SchedulerConfig{
-		PostingHours:      []int{cfg.PostingScheduleHour1, cfg.PostingScheduleHour2},
-		FollowUsersPerDay: cfg.FollowUsersPerDay,
-		LikePostsPerDay:   cfg.LikePostsPerDay,
-		RedditSubreddits:  cfg.RedditSubreddits,
-		MaxContentAgeDays: cfg.MaxContentAgeDays,
-		PostContentTheme:  cfg.PostContentTheme,
+	var seenStore store.SeenStore
+	if cfg.SeenStorePath != "" {
+		s, err := store.Open(cfg.SeenStorePath)
+		if err != nil {
+			log.Error("Failed to open seen store", "error", err)
+			os.Exit(1)
+		}
+		defer s.Close()
+		seenStore = s
 	}
 
-	schedulerAgent := internal.NewScheduler(
-		redditClient,
-		threadsClient,
+	var engagementCooldown store.SeenStore
+	if cfg.EngagementCooldownPath != "" {
+		s, err := store.Open(cfg.EngagementCooldownPath)
+		if err != nil {
+			log.Error("Failed to open engagement cooldown store", "error", err)
+			os.Exit(1)
+		}
+		defer s.Close()
+		engagementCooldown = s
+	}
+
+	var jobStore *scheduler.JobStore
+	if cfg.JobStorePath != "" {
+		jobStore, err = scheduler.OpenJobStore(cfg.JobStorePath)
+		if err != nil {
+			log.Error("Failed to open job store", "error", err)
+			os.Exit(1)
+		}
+		defer jobStore.Close()
+	}
+
+	var watchers watcher.Repository
+	if cfg.WatchersPath != "" {
+		repo, err := watcher.NewFileRepository(cfg.WatchersPath)
+		if err != nil {
+			log.Error("Failed to open watchers file", "error", err)
+			os.Exit(1)
+		}
+		watchers = repo
+	}
+
+	var bus pubsub.Bus
+	switch {
+	case cfg.NatsURL != "":
+		bus, err = pubsub.NewWatermillBus(cfg.NatsURL)
+		if err != nil {
+			log.Error("Failed to connect to NATS", "error", err)
+			os.Exit(1)
+		}
+	case cfg.PubsubOutboxPath != "":
+		bus, err = pubsub.OpenChannelBus(cfg.PubsubOutboxPath)
+		if err != nil {
+			log.Error("Failed to open pubsub outbox", "error", err)
+			os.Exit(1)
+		}
+	}
+	if bus != nil {
+		defer bus.Close()
+	}
+
+	var tl *timeline.Timeline
+	if cfg.TimelinePath != "" {
+		retention := time.Duration(cfg.TimelineRetentionDays) * 24 * time.Hour
+		tl, err = timeline.Open(cfg.TimelinePath, retention)
+		if err != nil {
+			log.Error("Failed to open timeline", "error", err)
+			os.Exit(1)
+		}
+		defer tl.Close()
+	}
+
+	sched := scheduler.New(
+		sourceRegistry,
+		redditSource,
+		posters,
 		postGen,
-		schedulerConfig,
-		log,
+		postLedger,
+		seenStore,
+		jobStore,
+		watchers,
+		bus,
+		tl,
+		cfg,
+		blueskyForEngagement,
+		engagementCooldown,
+		cfg.FollowSeedAccounts,
 	)
 
 	// Create context for graceful shutdown
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
+	if err := sched.Start(ctx); err != nil {
+		log.Error("Failed to start scheduler", "error", err)
+		os.Exit(1)
+	}
+
+	adminServer := startAdminServer(sched, cfg.AdminListenAddr, log)
+
 	// Handle signals for graceful shutdown
 	sigChan := make(chan os.Signal, 1)
 	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
 
-	// Start the scheduler
-	if err := schedulerAgent.Start(ctx); err != nil {
-		log.Error("Failed to start scheduler: %v", err)
-		os.Exit(1)
-	}
-
 	log.Info("Agent is running. Press Ctrl+C to shutdown.")
-	log.Info("Scheduled tasks:")
-	log.Info("  - Posts at: %02d:xx and %02d:xx daily", cfg.PostingScheduleHour1, cfg.PostingScheduleHour2)
-	log.Info("  - Follow %d users daily", cfg.FollowUsersPerDay)
-	log.Info("  - Like %d posts daily", cfg.LikePostsPerDay)
-	log.Info("  - Monitoring subreddits: %v", cfg.RedditSubreddits)
 
 	// Wait for shutdown signal
 	<-sigChan
 
 	log.Info("Shutdown signal received. Gracefully stopping...")
-	schedulerAgent.Stop()
+	if adminServer != nil {
+		shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), adminShutdownTimeout)
+		defer shutdownCancel()
+		if err := adminServer.Shutdown(shutdownCtx); err != nil {
+			log.Warn("admin server shutdown error", "error", err)
+		}
+	}
+	sched.Stop()
+
+	log.Info("Social Agent stopped.")
+}
+
+// adminShutdownTimeout bounds how long the admin HTTP server (job CRUD, the
+// /events SSE feed, and /metrics) gets to drain in-flight requests and
+// connected SSE clients before the process exits.
+const adminShutdownTimeout = 5 * time.Second
+
+// startAdminServer starts the scheduler's admin HTTP API - job CRUD, an
+// /events SSE feed broadcasting job run/progress activity, and a /metrics
+// Prometheus endpoint - on addr, or does nothing and returns nil if addr is
+// empty.
+func startAdminServer(sched *scheduler.Scheduler, addr string, log *slog.Logger) *scheduler.AdminServer {
+	if addr == "" {
+		return nil
+	}
 
-	log.Info("Threads Influencer Agent stopped.")
+	adminServer := scheduler.NewAdminServer(sched, addr)
+	go func() {
+		if err := adminServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Error("admin server stopped", "error", err)
+		}
+	}()
+	log.Info("scheduler admin API listening", "addr", addr)
+	return adminServer
 }
@@ -0,0 +1,144 @@
+// Command watchers manages the follow/like targeting rules evaluated by
+// internal/scheduler's followRoutine and likeRoutine (see
+// internal/watcher). It ships as its own small binary, separate from the
+// cmd/agent scheduler process, so operators can manage watchers against the
+// same WATCHERS_PATH file the scheduler reads without restarting it.
+package main
+
+import (
+	"crypto/rand"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+
+	"social-agent/config"
+	"social-agent/internal/watcher"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(1)
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to load config: %v\n", err)
+		os.Exit(1)
+	}
+	if cfg.WatchersPath == "" {
+		fmt.Fprintln(os.Stderr, "WATCHERS_PATH is not configured")
+		os.Exit(1)
+	}
+
+	repo, err := watcher.NewFileRepository(cfg.WatchersPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to open watchers file: %v\n", err)
+		os.Exit(1)
+	}
+
+	switch os.Args[1] {
+	case "add":
+		add(repo, os.Args[2:])
+	case "list":
+		list(repo)
+	case "rm":
+		remove(repo, os.Args[2:])
+	default:
+		usage()
+		os.Exit(1)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: watchers <add|list|rm> [options]")
+}
+
+func add(repo watcher.Repository, args []string) {
+	fs := flag.NewFlagSet("watchers add", flag.ExitOnError)
+	label := fs.String("label", "", "human-readable name for this watcher")
+	subreddit := fs.String("subreddit", "", "restrict matches to this subreddit")
+	minUpvotes := fs.Int("min-upvotes", 0, "minimum upvote count to match")
+	keywords := fs.String("keywords", "", "comma-separated keywords; matches if any appear in title or body")
+	flair := fs.String("flair", "", "restrict matches to this post flair")
+	allowlist := fs.String("allow", "", "comma-separated author allowlist")
+	blocklist := fs.String("block", "", "comma-separated author blocklist")
+	action := fs.String("action", string(watcher.ActionBoth), "follow|like|both")
+	disabled := fs.Bool("disabled", false, "create the watcher disabled")
+	fs.Parse(args)
+
+	w := watcher.Watcher{
+		ID:              ulid.MustNew(ulid.Timestamp(time.Now()), rand.Reader).String(),
+		Label:           *label,
+		Subreddit:       *subreddit,
+		MinUpvotes:      *minUpvotes,
+		Keywords:        splitCSV(*keywords),
+		Flair:           *flair,
+		AuthorAllowlist: splitCSV(*allowlist),
+		AuthorBlocklist: splitCSV(*blocklist),
+		Action:          watcher.Action(*action),
+		Enabled:         !*disabled,
+	}
+
+	if err := repo.Add(w); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to add watcher: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("added watcher %s (%s)\n", w.ID, w.Label)
+}
+
+func list(repo watcher.Repository) {
+	watchers, err := repo.List()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to list watchers: %v\n", err)
+		os.Exit(1)
+	}
+
+	if len(watchers) == 0 {
+		fmt.Println("no watchers configured")
+		return
+	}
+
+	for _, w := range watchers {
+		status := "enabled"
+		if !w.Enabled {
+			status = "disabled"
+		}
+		fmt.Printf("%s\t%-20s\t%s\t%s\tsubreddit=%s min_upvotes=%d flair=%q keywords=%v\n",
+			w.ID, w.Label, w.Action, status, w.Subreddit, w.MinUpvotes, w.Flair, w.Keywords)
+	}
+}
+
+func remove(repo watcher.Repository, args []string) {
+	if len(args) != 1 {
+		fmt.Fprintln(os.Stderr, "usage: watchers rm <id>")
+		os.Exit(1)
+	}
+
+	if err := repo.Remove(args[0]); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to remove watcher: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("removed watcher %s\n", args[0])
+}
+
+func splitCSV(s string) []string {
+	if s == "" {
+		return nil
+	}
+	parts := strings.Split(s, ",")
+	result := make([]string, 0, len(parts))
+	for _, part := range parts {
+		trimmed := strings.TrimSpace(part)
+		if trimmed != "" {
+			result = append(result, trimmed)
+		}
+	}
+	return result
+}
@@ -1,47 +1,254 @@
 package config
 
 import (
-	"github.com/joho/godotenv"
 	"os"
 	"strconv"
+	"strings"
+
+	"github.com/joho/godotenv"
 )
 
+// Config holds every environment-derived setting for the cmd/agent binary
+// and the internal/scheduler-based subsystems it wires up (pluggable
+// sources, DB-backed jobs, watchers, pubsub, timeline), as well as the
+// cmd/watchers management binary. A single Config is loaded once by Load
+// and passed down to whatever a given binary wires up.
 type Config struct {
 	// Credentials
-	TwitterXBearerToken string
-	BlueskyAccessToken  string
-	BlueskyDID          string
-	GeminiAPIKey        string
+	TwitterXBearerToken   string
+	TwitterConsumerKey    string
+	TwitterConsumerSecret string
+	TwitterAccessToken    string
+	TwitterAccessSecret   string
+	BlueskyAccessToken    string
+	BlueskyDID            string
+	// BlueskyIdentifier/BlueskyAppPassword authenticate via
+	// com.atproto.server.createSession instead of a static access token,
+	// letting internal/social/bluesky.NewWithCredentials transparently
+	// refresh the session when it expires. Preferred over
+	// BlueskyAccessToken/BlueskyDID when both are set.
+	BlueskyIdentifier  string
+	BlueskyAppPassword string
+	GeminiAPIKey       string
+	// Threads API Configuration
+	ThreadsAPIKey      string
+	ThreadsAccessToken string
+	// Mastodon REST API Configuration
+	// (internal/social/mastodon.NewMastodonClient), distinct from the raw
+	// ActivityPub credentials below.
+	MastodonInstanceURL string
+	MastodonAccessToken string
+	// Content Generation Configuration
+	ContentProvider    string
+	ContentModel       string
+	ContentAPIKey      string
+	ContentBaseURL     string
+	ContentTemperature float64
+	ContentMaxTokens   int
+	// ContentDryRun, when true, has content.New return a generator that
+	// echoes its rendered prompt back instead of calling an LLM API (see
+	// content.Config.DryRun).
+	ContentDryRun bool
+	// PromptTemplatePath loads a custom content.PromptTemplate from disk;
+	// empty uses content.DefaultPromptTemplate.
+	PromptTemplatePath string
+	// ContentMinChars/ContentBannedTerms/ContentRequiredTone configure the
+	// content.Validator run over generated posts before Agent.Generate
+	// returns them. ContentRequiredTone empty skips the tone check.
+	ContentMinChars     int
+	ContentBannedTerms  []string
+	ContentRequiredTone string
+	// ActivityPub/Mastodon Configuration
+	ActivityPubDomain         string
+	ActivityPubUsername       string
+	ActivityPubPrivateKeyPath string
+	// Reddit Configuration
+	RedditClientID     string
+	RedditClientSecret string
+	RedditUsername     string
+	RedditPassword     string
+	RedditUserAgent    string
+	RedditSubreddits   []string
+	// Ledger Configuration
+	LedgerPath string
+	// SeenStorePath is where store.Store persists which source posts have
+	// already been consumed (see internal/store). Empty disables the
+	// scheduler's seen-source filtering.
+	SeenStorePath string
+	// EngagementCooldownPath is where store.Store persists which accounts
+	// and posts the engagement subsystem has recently followed or liked,
+	// so they're excluded from future candidate pools. Empty disables the
+	// cooldown filter.
+	EngagementCooldownPath string
+	// JobStorePath is where the scheduler's bbolt-backed JobStore persists
+	// its posting/follow/like schedules (see internal/scheduler).
+	JobStorePath string
+	// WatchersPath is where the watcher.FileRepository persists follow/like
+	// targeting rules (see internal/watcher).
+	WatchersPath string
+	// PubsubOutboxPath is where pubsub.ChannelBus persists its outbox; leave
+	// NatsURL empty to use it as the scheduler's bus. Set NatsURL to use
+	// pubsub.WatermillBus against a shared NATS JetStream server instead.
+	PubsubOutboxPath string
+	NatsURL          string
+	// SourceQuotas caps how many candidates source.Registry.FetchAll keeps
+	// from each content source, keyed by source.Source.Name() (e.g.
+	// "twitter", "reddit"). A source with no entry here is unlimited.
+	SourceQuotas map[string]int
+	// TimelinePath is where the scheduler's timeline.Timeline persists its
+	// seen/generated/posted feed and content-hash collision index (see
+	// internal/timeline). Empty disables the timeline.
+	TimelinePath string
+	// TimelineRetentionDays bounds how long timeline.Timeline keeps entries
+	// before its compaction goroutine trims them. 0 disables compaction.
+	TimelineRetentionDays int
+	// TimelineCollisionWindowDays is how far back timeline.Timeline.RecentCollision
+	// looks for a matching content hash before the scheduler rejects a
+	// generated post as a near-duplicate repost.
+	TimelineCollisionWindowDays int
+	// Admin HTTP server (see internal/scheduler.AdminServer and
+	// Scheduler.Events): serves the live-activity SSE feed at /events and
+	// Prometheus metrics at /metrics. Empty AdminListenAddr disables it.
+	AdminListenAddr string
 	// Agent Configuration
+	LikePostsPerDay   int
+	MaxContentAgeDays int
+	PostContentTheme  string
+	// PostingScheduleHour1/PostingScheduleHour2 are loaded from
+	// POSTING_SCHEDULE_HOUR_1/2 but read by nothing: posting times come from
+	// the per-target cron jobs in internal/scheduler.JobStore.
 	PostingScheduleHour1 int
 	PostingScheduleHour2 int
-	FollowUsersPerDay    int
-	LikePostsPerDay      int
-	MaxContentAgeDays    int
-	PostContentTheme     string
-	LogLevel             string
+	// FollowUsersPerDay is loaded from FOLLOW_USERS_PER_DAY but read by
+	// nothing; FollowPerDay is the follow budget internal/scheduler
+	// applies.
+	FollowUsersPerDay int
+	// FollowPerDay caps how many accounts internal/social/engagement's
+	// follow routine follows per run.
+	FollowPerDay int
+	// EngagementCooldownHours is how long a followed account or liked post
+	// is excluded from future engagement candidate pools.
+	EngagementCooldownHours int
+	// EngagementJitterSeconds is the maximum random extra delay the
+	// engagement subsystem sleeps between follow/like actions.
+	EngagementJitterSeconds int
+	// EngagementMaxAgeHours drops Bluesky posts older than this from the
+	// like routine's candidate pool; 0 disables the recency filter.
+	EngagementMaxAgeHours int
+	// EngagementAllowedLangs restricts the like routine to posts in these
+	// BCP-47 language codes; empty allows any language.
+	EngagementAllowedLangs []string
+	// EngagementBannedTerms excludes a post from the like routine if its
+	// text contains any of these terms, case-insensitively.
+	EngagementBannedTerms []string
+	// FollowSeedAccounts is a small set of Bluesky handles whose follows
+	// are worth discovering as follow candidates (see
+	// internal/social/engagement.DiscoverFollowCandidates); stands in for
+	// "accounts we already follow" since the agent has no API to enumerate
+	// its own following list.
+	FollowSeedAccounts []string
+	LogLevel           string
+	// LogFormat selects the log handler: "json" or "text" (default).
+	LogFormat string
+	// LogModuleLevels overrides LogLevel per "module" attribute, parsed from
+	// a "twitter=debug,scheduler=info" string (see internal/logging).
+	LogModuleLevels map[string]string
+	// LogFilePath, if set, writes logs to a rotating file instead of
+	// stdout.
+	LogFilePath string
 }
 
-// Load reads configuration from environment variables and returns config struct
+// Load reads configuration from environment variables (and a .env file, if
+// present) and returns the populated Config.
 func Load() (*Config, error) {
 	_ = godotenv.Load()
+
 	cfg := &Config{
-		TwitterXBearerToken:  os.Getenv("TWITTER_X_BEARER_TOKEN"),
-		BlueskyAccessToken:   os.Getenv("BLUESKY_ACCESS_TOKEN"),
-		BlueskyDID:           os.Getenv("BLUESKY_DID"),
-		GeminiAPIKey:         os.Getenv("GEMINI_API_KEY"),
-		// TODO: posting should not be done at fixed hours
-		PostingScheduleHour1: getEnvInt("POSTING_SCHEDULE_HOUR_1", 0),
-		PostingScheduleHour2: getEnvInt("POSTING_SCHEDULE_HOUR_2", 0),
-		FollowUsersPerDay:    getEnvInt("FOLLOW_USERS_PER_DAY", 0),
-		LikePostsPerDay:      getEnvInt("LIKE_POSTS_PER_DAY", 0),
-		MaxContentAgeDays:    getEnvInt("MAX_CONTENT_AGE_DAYS", 0),
-		PostContentTheme:     os.Getenv("POST_CONTENT_THEME"),
-		LogLevel:             os.Getenv("LOG_LEVEL"),
+		TwitterXBearerToken:   os.Getenv("TWITTER_X_BEARER_TOKEN"),
+		TwitterConsumerKey:    os.Getenv("TWITTER_CONSUMER_KEY"),
+		TwitterConsumerSecret: os.Getenv("TWITTER_CONSUMER_SECRET"),
+		TwitterAccessToken:    os.Getenv("TWITTER_ACCESS_TOKEN"),
+		TwitterAccessSecret:   os.Getenv("TWITTER_ACCESS_SECRET"),
+		BlueskyAccessToken:    os.Getenv("BLUESKY_ACCESS_TOKEN"),
+		BlueskyDID:            os.Getenv("BLUESKY_DID"),
+		BlueskyIdentifier:     os.Getenv("BLUESKY_IDENTIFIER"),
+		BlueskyAppPassword:    os.Getenv("BLUESKY_APP_PASSWORD"),
+		GeminiAPIKey:          os.Getenv("GEMINI_API_KEY"),
+		ThreadsAPIKey:         getEnv("THREADS_API_KEY", ""),
+		ThreadsAccessToken:    getEnv("THREADS_ACCESS_TOKEN", ""),
+		MastodonInstanceURL:   getEnv("MASTODON_INSTANCE_URL", ""),
+		MastodonAccessToken:   getEnv("MASTODON_ACCESS_TOKEN", ""),
+
+		ContentProvider:     os.Getenv("CONTENT_PROVIDER"),
+		ContentModel:        os.Getenv("CONTENT_MODEL"),
+		ContentAPIKey:       os.Getenv("CONTENT_API_KEY"),
+		ContentBaseURL:      os.Getenv("CONTENT_BASE_URL"),
+		ContentTemperature:  getEnvFloat("CONTENT_TEMPERATURE", 0),
+		ContentMaxTokens:    getEnvInt("CONTENT_MAX_TOKENS", 0),
+		ContentDryRun:       getEnvBool("CONTENT_DRY_RUN", false),
+		PromptTemplatePath:  os.Getenv("PROMPT_TEMPLATE_PATH"),
+		ContentMinChars:     getEnvInt("CONTENT_MIN_CHARS", 0),
+		ContentBannedTerms:  parseStringList(os.Getenv("CONTENT_BANNED_TERMS")),
+		ContentRequiredTone: os.Getenv("CONTENT_REQUIRED_TONE"),
+
+		ActivityPubDomain:         os.Getenv("ACTIVITYPUB_DOMAIN"),
+		ActivityPubUsername:       os.Getenv("ACTIVITYPUB_USERNAME"),
+		ActivityPubPrivateKeyPath: os.Getenv("ACTIVITYPUB_PRIVATE_KEY_PATH"),
+
+		RedditClientID:     getEnv("REDDIT_CLIENT_ID", ""),
+		RedditClientSecret: getEnv("REDDIT_CLIENT_SECRET", ""),
+		RedditUsername:     getEnv("REDDIT_USERNAME", ""),
+		RedditPassword:     getEnv("REDDIT_PASSWORD", ""),
+		RedditUserAgent:    getEnv("REDDIT_USER_AGENT", "ThreadsInfluencerAgent/1.0"),
+		RedditSubreddits:   parseStringList(getEnv("REDDIT_SUBREDDITS", "antiwork,mildlyinfuriating")),
+
+		LedgerPath:             os.Getenv("LEDGER_PATH"),
+		SeenStorePath:          os.Getenv("SEEN_STORE_PATH"),
+		EngagementCooldownPath: os.Getenv("ENGAGEMENT_COOLDOWN_PATH"),
+		JobStorePath:           os.Getenv("JOB_STORE_PATH"),
+		WatchersPath:           os.Getenv("WATCHERS_PATH"),
+		PubsubOutboxPath:       os.Getenv("PUBSUB_OUTBOX_PATH"),
+		NatsURL:                os.Getenv("NATS_URL"),
+		SourceQuotas:           parseIntMap(os.Getenv("SOURCE_QUOTAS")),
+
+		TimelinePath:                os.Getenv("TIMELINE_PATH"),
+		TimelineRetentionDays:       getEnvInt("TIMELINE_RETENTION_DAYS", 0),
+		TimelineCollisionWindowDays: getEnvInt("TIMELINE_COLLISION_WINDOW_DAYS", 3),
+
+		AdminListenAddr: os.Getenv("ADMIN_LISTEN_ADDR"),
+
+		LikePostsPerDay:      getEnvInt("LIKE_POSTS_PER_DAY", 5),
+		MaxContentAgeDays:    getEnvInt("MAX_CONTENT_AGE_DAYS", 3),
+		PostContentTheme:     getEnv("POST_CONTENT_THEME", "i work with fools"),
+		PostingScheduleHour1: getEnvInt("POSTING_SCHEDULE_HOUR_1", 8),
+		PostingScheduleHour2: getEnvInt("POSTING_SCHEDULE_HOUR_2", 18),
+		FollowUsersPerDay:    getEnvInt("FOLLOW_USERS_PER_DAY", 3),
+
+		FollowPerDay:            getEnvInt("FOLLOW_PER_DAY", 3),
+		EngagementCooldownHours: getEnvInt("ENGAGEMENT_COOLDOWN_HOURS", 24*7),
+		EngagementJitterSeconds: getEnvInt("ENGAGEMENT_JITTER_SECONDS", 30),
+		EngagementMaxAgeHours:   getEnvInt("ENGAGEMENT_MAX_AGE_HOURS", 48),
+		EngagementAllowedLangs:  parseStringList(os.Getenv("ENGAGEMENT_ALLOWED_LANGS")),
+		EngagementBannedTerms:   parseStringList(os.Getenv("ENGAGEMENT_BANNED_TERMS")),
+		FollowSeedAccounts:      parseStringList(os.Getenv("FOLLOW_SEED_ACCOUNTS")),
+
+		LogLevel:        getEnv("LOG_LEVEL", "info"),
+		LogFormat:       os.Getenv("LOG_FORMAT"),
+		LogModuleLevels: parseStringMap(os.Getenv("LOG_MODULE_LEVELS")),
+		LogFilePath:     os.Getenv("LOG_FILE_PATH"),
 	}
+
 	return cfg, nil
 }
 
+// getEnv retrieves a string environment variable or returns a default value.
+func getEnv(key, defaultVal string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultVal
+}
+
 // getEnvInt retrieves an integer environment variable or returns a default value
 func getEnvInt(key string, defaultVal int) int {
 	val := os.Getenv(key)
@@ -54,3 +261,85 @@ func getEnvInt(key string, defaultVal int) int {
 	}
 	return intVal
 }
+
+// getEnvFloat retrieves a float environment variable or returns a default value
+func getEnvFloat(key string, defaultVal float64) float64 {
+	val := os.Getenv(key)
+	if val == "" {
+		return defaultVal
+	}
+	floatVal, err := strconv.ParseFloat(val, 64)
+	if err != nil {
+		return defaultVal
+	}
+	return floatVal
+}
+
+// getEnvBool retrieves a boolean environment variable or returns a default
+// value.
+func getEnvBool(key string, defaultVal bool) bool {
+	val := os.Getenv(key)
+	if val == "" {
+		return defaultVal
+	}
+	boolVal, err := strconv.ParseBool(val)
+	if err != nil {
+		return defaultVal
+	}
+	return boolVal
+}
+
+// parseIntMap parses a "key:value,key2:value2" environment variable into a
+// map, skipping entries that are malformed or whose value isn't an
+// integer.
+func parseIntMap(s string) map[string]int {
+	result := make(map[string]int)
+	for _, pair := range strings.Split(s, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(pair, ":")
+		if !ok {
+			continue
+		}
+		intValue, err := strconv.Atoi(strings.TrimSpace(value))
+		if err != nil {
+			continue
+		}
+		result[strings.TrimSpace(key)] = intValue
+	}
+	return result
+}
+
+// parseStringMap parses a "key=value,key2=value2" environment variable
+// into a map, skipping entries that are malformed.
+func parseStringMap(s string) map[string]string {
+	result := make(map[string]string)
+	for _, pair := range strings.Split(s, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(pair, "=")
+		if !ok {
+			continue
+		}
+		result[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+	return result
+}
+
+// parseStringList splits a comma-separated environment variable into a
+// trimmed, non-empty string slice.
+func parseStringList(s string) []string {
+	parts := strings.Split(s, ",")
+	var result []string
+	for _, part := range parts {
+		trimmed := strings.TrimSpace(part)
+		if trimmed != "" {
+			result = append(result, trimmed)
+		}
+	}
+	return result
+}
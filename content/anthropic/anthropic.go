@@ -0,0 +1,115 @@
+// Package anthropic implements a content.ContentGenerator backed by
+// Anthropic's messages API.
+package anthropic
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"social-agent/internal/httpx"
+)
+
+const defaultModel = "claude-3-5-sonnet-20241022"
+const defaultMaxTokens = 1024
+const messagesURL = "https://api.anthropic.com/v1/messages"
+const anthropicVersion = "2023-06-01"
+
+// Generator generates post content using Anthropic's messages API.
+type Generator struct {
+	apiKey    string
+	model     string
+	maxTokens int
+	transport *httpx.Transport
+}
+
+// New creates an Anthropic-backed generator. If model is empty, it
+// defaults to claude-3-5-sonnet-20241022; if maxTokens is 0, it defaults
+// to 1024. Requests are retried with exponential backoff on 5xx/429 via
+// httpx.Transport.
+func New(apiKey, model string, maxTokens int) *Generator {
+	if model == "" {
+		model = defaultModel
+	}
+	if maxTokens == 0 {
+		maxTokens = defaultMaxTokens
+	}
+	return &Generator{
+		apiKey:    apiKey,
+		model:     model,
+		maxTokens: maxTokens,
+		transport: httpx.New(&http.Client{Timeout: 30 * time.Second}),
+	}
+}
+
+type message struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type messagesRequest struct {
+	Model     string    `json:"model"`
+	MaxTokens int       `json:"max_tokens"`
+	Messages  []message `json:"messages"`
+}
+
+type messagesResponse struct {
+	Content []struct {
+		Text string `json:"text"`
+	} `json:"content"`
+}
+
+// GeneratePost sends prompt to Anthropic's messages endpoint and returns
+// the generated text.
+func (g *Generator) GeneratePost(ctx context.Context, prompt string) (string, error) {
+	payload := messagesRequest{
+		Model:     g.model,
+		MaxTokens: g.maxTokens,
+		Messages:  []message{{Role: "user", Content: prompt}},
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal Anthropic request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", messagesURL, bytes.NewBuffer(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("x-api-key", g.apiKey)
+	req.Header.Set("anthropic-version", anthropicVersion)
+	req.Header.Set("Content-Type", "application/json")
+
+	_, respBody, _, err := g.transport.Do(ctx, req)
+	if err != nil {
+		switch {
+		case errors.Is(err, httpx.ErrAuthRevoked):
+			return "", fmt.Errorf("Anthropic request failed: API key rejected: %w", err)
+		case errors.Is(err, httpx.ErrRateLimited):
+			return "", fmt.Errorf("Anthropic request failed: rate limited: %w", err)
+		default:
+			return "", fmt.Errorf("Anthropic request failed: %w", err)
+		}
+	}
+
+	var parsed messagesResponse
+	if err := json.Unmarshal(respBody, &parsed); err != nil {
+		return "", fmt.Errorf("failed to decode Anthropic response: %w", err)
+	}
+
+	if len(parsed.Content) == 0 {
+		return "", fmt.Errorf("empty response from Anthropic")
+	}
+
+	generated := parsed.Content[0].Text
+	if generated == "" {
+		return "", fmt.Errorf("no text content in Anthropic response")
+	}
+
+	return generated, nil
+}
@@ -0,0 +1,22 @@
+// Package content builds a ContentGenerator from a provider-agnostic
+// Config, so the agent can run against Gemini, OpenAI, Anthropic, or a
+// local Ollama model without caring which one it was built with.
+package content
+
+// Config selects and configures an LLM backend for post generation.
+// Provider is one of "gemini", "openai", "anthropic", or "ollama".
+// BaseURL is only meaningful for Ollama, where it points at a local
+// server instead of a hosted API.
+type Config struct {
+	Provider    string
+	Model       string
+	APIKey      string
+	BaseURL     string
+	Temperature float64
+	MaxTokens   int
+	// DryRun, when true, bypasses Provider entirely and returns a
+	// ContentGenerator that echoes back its rendered prompt instead of
+	// calling an LLM API, so operators can inspect exactly what would be
+	// sent without spending an API call.
+	DryRun bool
+}
@@ -0,0 +1,65 @@
+// Package gemini implements a content.ContentGenerator backed by Google's
+// Gemini API.
+package gemini
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/genai"
+)
+
+const defaultModel = "gemini-2.5-flash"
+
+// Generator generates post content using Google's Gemini API.
+type Generator struct {
+	client *genai.Client
+	model  string
+}
+
+// New creates a Gemini-backed generator. If model is empty, it defaults to
+// gemini-2.5-flash.
+func New(apiKey, model string) (*Generator, error) {
+	ctx := context.Background()
+	client, err := genai.NewClient(ctx, &genai.ClientConfig{
+		APIKey: apiKey,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Gemini client: %w", err)
+	}
+
+	if model == "" {
+		model = defaultModel
+	}
+
+	return &Generator{client: client, model: model}, nil
+}
+
+// GeneratePost sends prompt to Gemini and returns the generated text.
+func (g *Generator) GeneratePost(ctx context.Context, prompt string) (string, error) {
+	resp, err := g.client.Models.GenerateContent(ctx, g.model, []*genai.Content{
+		{
+			Parts: []*genai.Part{
+				{Text: prompt},
+			},
+		},
+	}, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to call Gemini API: %w", err)
+	}
+
+	if len(resp.Candidates) == 0 {
+		return "", fmt.Errorf("empty response from Gemini")
+	}
+
+	var generated string
+	if len(resp.Candidates[0].Content.Parts) > 0 {
+		generated = resp.Candidates[0].Content.Parts[0].Text
+	}
+
+	if generated == "" {
+		return "", fmt.Errorf("no text content in Gemini response")
+	}
+
+	return generated, nil
+}
@@ -0,0 +1,47 @@
+package content
+
+import (
+	"context"
+	"fmt"
+
+	"social-agent/content/anthropic"
+	"social-agent/content/gemini"
+	"social-agent/content/ollama"
+	"social-agent/content/openai"
+)
+
+// ContentGenerator generates a social media post from a fully-rendered
+// prompt, regardless of which LLM backend is behind it.
+type ContentGenerator interface {
+	GeneratePost(ctx context.Context, prompt string) (string, error)
+}
+
+// New builds the ContentGenerator selected by cfg.Provider, or a
+// dryRunGenerator if cfg.DryRun is set, regardless of Provider.
+func New(cfg Config) (ContentGenerator, error) {
+	if cfg.DryRun {
+		return dryRunGenerator{}, nil
+	}
+
+	switch cfg.Provider {
+	case "gemini":
+		return gemini.New(cfg.APIKey, cfg.Model)
+	case "openai":
+		return openai.New(cfg.APIKey, cfg.Model, cfg.Temperature, cfg.MaxTokens), nil
+	case "anthropic":
+		return anthropic.New(cfg.APIKey, cfg.Model, cfg.MaxTokens), nil
+	case "ollama":
+		return ollama.New(cfg.BaseURL, cfg.Model), nil
+	default:
+		return nil, fmt.Errorf("content: unknown provider %q", cfg.Provider)
+	}
+}
+
+// dryRunGenerator implements ContentGenerator by returning its prompt
+// unchanged, so Config.DryRun can inspect a rendered prompt without
+// spending a call against any LLM API.
+type dryRunGenerator struct{}
+
+func (dryRunGenerator) GeneratePost(ctx context.Context, prompt string) (string, error) {
+	return prompt, nil
+}
@@ -0,0 +1,92 @@
+// Package ollama implements a content.ContentGenerator backed by a local
+// Ollama server, so the agent can run against a local model without any
+// hosted API key.
+package ollama
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"social-agent/internal/httpx"
+)
+
+const defaultBaseURL = "http://localhost:11434"
+const defaultModel = "llama3"
+
+// Generator generates post content using a local Ollama server's
+// /api/generate endpoint.
+type Generator struct {
+	baseURL   string
+	model     string
+	transport *httpx.Transport
+}
+
+// New creates an Ollama-backed generator against baseURL (defaulting to
+// http://localhost:11434) and model (defaulting to llama3). Requests are
+// retried with exponential backoff on 5xx/429 via httpx.Transport, though a
+// local server rarely returns either.
+func New(baseURL, model string) *Generator {
+	if baseURL == "" {
+		baseURL = defaultBaseURL
+	}
+	if model == "" {
+		model = defaultModel
+	}
+	return &Generator{
+		baseURL:   baseURL,
+		model:     model,
+		transport: httpx.New(&http.Client{Timeout: 60 * time.Second}),
+	}
+}
+
+type generateRequest struct {
+	Model  string `json:"model"`
+	Prompt string `json:"prompt"`
+	Stream bool   `json:"stream"`
+}
+
+type generateResponse struct {
+	Response string `json:"response"`
+}
+
+// GeneratePost sends prompt to the Ollama server's /api/generate endpoint
+// and returns the generated text. Streaming is disabled so the full
+// response comes back as a single JSON object.
+func (g *Generator) GeneratePost(ctx context.Context, prompt string) (string, error) {
+	payload := generateRequest{
+		Model:  g.model,
+		Prompt: prompt,
+		Stream: false,
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal Ollama request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", g.baseURL+"/api/generate", bytes.NewBuffer(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	_, respBody, _, err := g.transport.Do(ctx, req)
+	if err != nil {
+		return "", fmt.Errorf("Ollama request failed: %w", err)
+	}
+
+	var parsed generateResponse
+	if err := json.Unmarshal(respBody, &parsed); err != nil {
+		return "", fmt.Errorf("failed to decode Ollama response: %w", err)
+	}
+
+	if parsed.Response == "" {
+		return "", fmt.Errorf("no text content in Ollama response")
+	}
+
+	return parsed.Response, nil
+}
@@ -0,0 +1,112 @@
+// Package openai implements a content.ContentGenerator backed by OpenAI's
+// chat completions API.
+package openai
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"social-agent/internal/httpx"
+)
+
+const defaultModel = "gpt-4o-mini"
+const chatCompletionsURL = "https://api.openai.com/v1/chat/completions"
+
+// Generator generates post content using OpenAI's chat completions API.
+type Generator struct {
+	apiKey      string
+	model       string
+	temperature float64
+	maxTokens   int
+	transport   *httpx.Transport
+}
+
+// New creates an OpenAI-backed generator. If model is empty, it defaults
+// to gpt-4o-mini. Requests are retried with exponential backoff on 5xx/429
+// via httpx.Transport.
+func New(apiKey, model string, temperature float64, maxTokens int) *Generator {
+	if model == "" {
+		model = defaultModel
+	}
+	return &Generator{
+		apiKey:      apiKey,
+		model:       model,
+		temperature: temperature,
+		maxTokens:   maxTokens,
+		transport:   httpx.New(&http.Client{Timeout: 30 * time.Second}),
+	}
+}
+
+type chatMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type chatCompletionsRequest struct {
+	Model       string        `json:"model"`
+	Messages    []chatMessage `json:"messages"`
+	Temperature float64       `json:"temperature,omitempty"`
+	MaxTokens   int           `json:"max_tokens,omitempty"`
+}
+
+type chatCompletionsResponse struct {
+	Choices []struct {
+		Message chatMessage `json:"message"`
+	} `json:"choices"`
+}
+
+// GeneratePost sends prompt to OpenAI's chat completions endpoint and
+// returns the generated text.
+func (g *Generator) GeneratePost(ctx context.Context, prompt string) (string, error) {
+	payload := chatCompletionsRequest{
+		Model:       g.model,
+		Messages:    []chatMessage{{Role: "user", Content: prompt}},
+		Temperature: g.temperature,
+		MaxTokens:   g.maxTokens,
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal OpenAI request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", chatCompletionsURL, bytes.NewBuffer(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Authorization", "Bearer "+g.apiKey)
+	req.Header.Set("Content-Type", "application/json")
+
+	_, respBody, _, err := g.transport.Do(ctx, req)
+	if err != nil {
+		switch {
+		case errors.Is(err, httpx.ErrAuthRevoked):
+			return "", fmt.Errorf("OpenAI request failed: API key rejected: %w", err)
+		case errors.Is(err, httpx.ErrRateLimited):
+			return "", fmt.Errorf("OpenAI request failed: rate limited: %w", err)
+		default:
+			return "", fmt.Errorf("OpenAI request failed: %w", err)
+		}
+	}
+
+	var parsed chatCompletionsResponse
+	if err := json.Unmarshal(respBody, &parsed); err != nil {
+		return "", fmt.Errorf("failed to decode OpenAI response: %w", err)
+	}
+
+	if len(parsed.Choices) == 0 {
+		return "", fmt.Errorf("empty response from OpenAI")
+	}
+
+	generated := parsed.Choices[0].Message.Content
+	if generated == "" {
+		return "", fmt.Errorf("no text content in OpenAI response")
+	}
+
+	return generated, nil
+}
@@ -0,0 +1,95 @@
+package content
+
+import "github.com/rivo/uniseg"
+
+// Platform identifies the destination a generated post is headed for, so
+// TruncateForPlatform and CharCount can apply the right grapheme limit
+// instead of a single hard-coded one.
+type Platform string
+
+const (
+	PlatformThreads Platform = "threads"
+	PlatformBluesky Platform = "bluesky"
+	PlatformTwitter Platform = "twitter"
+)
+
+// charLimits holds the per-platform grapheme-cluster budget a post must
+// fit within. A platform with no entry is left unbounded by CharCount and
+// TruncateForPlatform.
+var charLimits = map[Platform]int{
+	PlatformThreads: 500,
+	PlatformBluesky: 300,
+	PlatformTwitter: 280,
+}
+
+// CharCount returns the number of grapheme clusters in text, counting the
+// way a user (and the platform's own composer) would rather than counting
+// bytes or runes, which mis-count combined emoji, CJK, and RTL text. The
+// platform argument is currently unused by the count itself but keeps the
+// signature symmetric with TruncateForPlatform and leaves room for a
+// platform-specific counting quirk later.
+func CharCount(platform Platform, text string) int {
+	return uniseg.GraphemeClusterCount(text)
+}
+
+// TruncateForPlatform trims text to platform's grapheme-cluster limit,
+// breaking on a grapheme boundary so a multi-byte rune or combined emoji
+// is never cut in half, and preferring the nearest preceding word boundary
+// so RTL and CJK text aren't truncated mid-word. An ellipsis is appended
+// whenever text was shortened. Platforms with no configured limit are
+// returned unchanged.
+func TruncateForPlatform(text string, platform Platform) string {
+	limit, ok := charLimits[platform]
+	if !ok {
+		return text
+	}
+	if CharCount(platform, text) <= limit {
+		return text
+	}
+
+	// budget leaves room for the trailing "...".
+	budget := limit - 3
+	if budget <= 0 {
+		return "..."
+	}
+
+	gr := uniseg.NewGraphemes(text)
+	var (
+		count        int
+		cut          int
+		lastWordEnd  int
+		sawWordBreak bool
+	)
+	for gr.Next() {
+		start, end := gr.Positions()
+		count++
+		if count > budget {
+			break
+		}
+		cut = end
+
+		// A word boundary exists wherever this cluster is whitespace; cut
+		// there instead of mid-word when we have to give something up.
+		if isWordBreakCluster(text[start:end]) {
+			lastWordEnd = start
+			sawWordBreak = true
+		}
+	}
+
+	if sawWordBreak && lastWordEnd > 0 {
+		cut = lastWordEnd
+	}
+
+	return text[:cut] + "..."
+}
+
+// isWordBreakCluster reports whether cluster is whitespace, and therefore a
+// safe place to break a line of RTL or CJK text without splitting a word.
+func isWordBreakCluster(cluster string) bool {
+	for _, r := range cluster {
+		if r != ' ' && r != '\t' && r != '\n' {
+			return false
+		}
+	}
+	return cluster != ""
+}
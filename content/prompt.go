@@ -0,0 +1,75 @@
+package content
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"text/template"
+)
+
+// PromptData parameterizes a PromptTemplate with everything it needs to
+// describe a source post and its destination to render a generation
+// prompt.
+type PromptData struct {
+	Theme        string
+	SourceTitle  string
+	SourceBody   string
+	SourceAuthor string
+	Platform     string
+	MaxChars     int
+}
+
+// DefaultPromptTemplate is the built-in workplace-frustration prompt used
+// when no template path is configured.
+const DefaultPromptTemplate = `You are a humorous social media content creator specializing in workplace frustration content.
+Your task is to create an engaging social media post based on a {{.Platform}} post that embodies the theme: "{{.Theme}}"
+
+{{.Platform}} post by {{.SourceAuthor}}:
+{{.SourceBody}}
+
+Requirements:
+1. Transform the source post into a relatable, humorous social media post about workplace frustrations
+2. The post should be under {{.MaxChars}} characters
+3. Use conversational, natural language appropriate for social media
+4. Incorporate subtle humor and frustration about office dynamics, coworkers, or work situations
+5. Make it engaging and likely to resonate with people frustrated at work
+6. Do NOT include hashtags unless they naturally fit
+7. Keep it authentic and relatable, not preachy
+8. Optionally include a mild question or observation that invites engagement
+
+Generate ONLY the post content, nothing else.`
+
+// PromptTemplate renders a generation prompt from a Go text/template, so
+// operators can restyle the prompt, or swap themes entirely, without a
+// code change.
+type PromptTemplate struct {
+	tmpl *template.Template
+}
+
+// NewPromptTemplate parses text as a prompt template.
+func NewPromptTemplate(text string) (*PromptTemplate, error) {
+	tmpl, err := template.New("prompt").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse prompt template: %w", err)
+	}
+	return &PromptTemplate{tmpl: tmpl}, nil
+}
+
+// LoadPromptTemplate reads and parses a prompt template from disk.
+func LoadPromptTemplate(path string) (*PromptTemplate, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read prompt template %s: %w", path, err)
+	}
+	return NewPromptTemplate(string(raw))
+}
+
+// Render fills the template with data, producing the prompt to send to a
+// ContentGenerator.
+func (p *PromptTemplate) Render(data PromptData) (string, error) {
+	var buf bytes.Buffer
+	if err := p.tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("failed to render prompt template: %w", err)
+	}
+	return buf.String(), nil
+}
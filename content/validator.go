@@ -0,0 +1,80 @@
+package content
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// Validator runs a pipeline of checks over generated post content before
+// Agent.Generate returns it, so malformed or off-brand output fails loudly
+// instead of getting posted.
+type Validator struct {
+	// Platform is passed to CharCount so MinChars/MaxChars are checked
+	// against grapheme clusters the same way TruncateForPlatform counts
+	// them, instead of bytes or runes.
+	Platform    Platform
+	MinChars    int
+	MaxChars    int
+	BannedTerms []string
+	// ToneChecker, if set, is given a second GeneratePost call asking
+	// whether generated text matches RequiredTone. A nil ToneChecker skips
+	// the tone check.
+	ToneChecker  ContentGenerator
+	RequiredTone string
+}
+
+// NewValidator builds a Validator. toneChecker may be nil, in which case
+// Validate skips the tone check and requiredTone is ignored.
+func NewValidator(platform Platform, minChars, maxChars int, bannedTerms []string, toneChecker ContentGenerator, requiredTone string) *Validator {
+	return &Validator{
+		Platform:     platform,
+		MinChars:     minChars,
+		MaxChars:     maxChars,
+		BannedTerms:  bannedTerms,
+		ToneChecker:  toneChecker,
+		RequiredTone: requiredTone,
+	}
+}
+
+// Validate runs every configured check against text in order, returning
+// the first failure. Length failures report grapheme counts so a caller
+// that feeds the error back into a retry prompt (e.g. "you produced 312
+// graphemes, limit is 300") is telling the model something it can act on.
+func (v *Validator) Validate(ctx context.Context, text string) error {
+	count := CharCount(v.Platform, text)
+	if v.MinChars > 0 && count < v.MinChars {
+		return fmt.Errorf("content: generated post is %d graphemes, shorter than the %d minimum", count, v.MinChars)
+	}
+	if v.MaxChars > 0 && count > v.MaxChars {
+		return fmt.Errorf("content: generated post is %d graphemes, longer than the %d maximum", count, v.MaxChars)
+	}
+
+	lower := strings.ToLower(text)
+	for _, term := range v.BannedTerms {
+		if term == "" {
+			continue
+		}
+		if strings.Contains(lower, strings.ToLower(term)) {
+			return fmt.Errorf("content: generated post contains banned term %q", term)
+		}
+	}
+
+	if v.ToneChecker == nil || v.RequiredTone == "" {
+		return nil
+	}
+
+	prompt := fmt.Sprintf(
+		"Does the following social media post match this tone: %q? Respond with only \"yes\" or \"no\".\n\nPost:\n%s",
+		v.RequiredTone, text,
+	)
+	verdict, err := v.ToneChecker.GeneratePost(ctx, prompt)
+	if err != nil {
+		return fmt.Errorf("failed to run tone check: %w", err)
+	}
+	if !strings.Contains(strings.ToLower(verdict), "yes") {
+		return fmt.Errorf("content: generated post failed tone check for tone %q", v.RequiredTone)
+	}
+
+	return nil
+}
@@ -3,21 +3,51 @@ package agent
 import (
 	"context"
 	"fmt"
-	"google.golang.org/genai"
 	"log/slog"
-	"social-agent/internal/social/twitter"
 	"time"
+
+	"social-agent/content"
 )
 
-// ContentGenerator generates social media posts from Twitter/X posts
+// SourcePost is a source-agnostic view of content pulled from any
+// ContentSource (Twitter, Reddit, ...) so the generator doesn't need to care
+// where it came from.
+type SourcePost struct {
+	// ID is the stable identifier of the post within its source (a Reddit
+	// fullname, a tweet ID, ...), used by the ledger to dedupe across runs.
+	ID        string
+	Content   string
+	Author    string
+	URL       string
+	CreatedAt time.Time
+	Source    string
+}
+
+// ContentGenerator generates a social media post from a fully-rendered
+// prompt. Every content package backend (Gemini, OpenAI, Anthropic,
+// Ollama) satisfies this the same way, so Agent doesn't care which one it
+// was built with.
 type ContentGenerator interface {
-	GeneratePost(ctx context.Context, post *twitter.Post, theme string) (string, error)
+	GeneratePost(ctx context.Context, prompt string) (string, error)
 }
 
-// Agent generates posts from Twitter/X and posts to social media.
+// fanoutPlatform is the platform a generated post is sized against. A
+// post fans out to every configured destination (see
+// scheduler.Scheduler.postToAll), so it's held to Bluesky's limit, the most
+// restrictive of them, to guarantee it fits everywhere it's posted.
+const fanoutPlatform = content.PlatformBluesky
+
+// maxPostChars is the grapheme budget a generated post is held to, both
+// as the {{.MaxChars}} value rendered into the prompt and as the ceiling
+// content.TruncateForPlatform falls back to if a backend ignores it.
+const maxPostChars = 300
+
+// Agent generates posts from source content and posts to social media.
 type Agent struct {
-	contentGen ContentGenerator
-	theme      string
+	contentGen     ContentGenerator
+	theme          string
+	promptTemplate *content.PromptTemplate
+	validator      *content.Validator
 }
 
 // GeneratedPost is a post ready to be posted to social media.
@@ -26,127 +56,70 @@ type GeneratedPost struct {
 	CreatedAt time.Time
 }
 
-// New creates a new post generation agent with Gemini as the content generator.
-func New(apiKey string, theme string) (*Agent, error) {
-	gen, err := newGemini(apiKey)
+// New creates a new post generation agent using the backend selected by
+// cfg.Provider (see content.New). promptTemplatePath loads a custom
+// content.PromptTemplate from disk; empty uses content.DefaultPromptTemplate.
+// validator may be nil, in which case generated content is never rejected
+// for length, banned terms, or tone.
+func New(cfg content.Config, theme, promptTemplatePath string, validator *content.Validator) (*Agent, error) {
+	gen, err := content.New(cfg)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("failed to initialize content generator: %w", err)
 	}
 
-	slog.Debug("Initializing agent with theme: %s", theme)
-	return &Agent{
-		contentGen: gen,
-		theme:      theme,
-	}, nil
-}
-
-// NewGemini creates a new Gemini-based generator.
-func newGemini(apiKey string) (*GeminiGenerator, error) {
-	ctx := context.Background()
-	client, err := genai.NewClient(ctx, &genai.ClientConfig{
-		APIKey: apiKey,
-	})
+	var tmpl *content.PromptTemplate
+	if promptTemplatePath != "" {
+		tmpl, err = content.LoadPromptTemplate(promptTemplatePath)
+	} else {
+		tmpl, err = content.NewPromptTemplate(content.DefaultPromptTemplate)
+	}
 	if err != nil {
-		return nil, fmt.Errorf("failed to create Gemini client: %w", err)
+		return nil, fmt.Errorf("failed to load prompt template: %w", err)
 	}
 
-	slog.Info("Initializing gemini content generator")
-	return &GeminiGenerator{
-		client: client,
+	slog.Debug("Initializing agent", "theme", theme)
+	return &Agent{
+		contentGen:     gen,
+		theme:          theme,
+		promptTemplate: tmpl,
+		validator:      validator,
 	}, nil
 }
 
-// Generate creates a social media post from a Twitter/X post.
-func (a *Agent) Generate(ctx context.Context, post *twitter.Post) (*GeneratedPost, error) {
+// Generate creates a social media post from a source post.
+func (a *Agent) Generate(ctx context.Context, post *SourcePost) (*GeneratedPost, error) {
 	if post == nil {
 		return nil, fmt.Errorf("post is nil")
 	}
 
-	socialContent, err := a.contentGen.GeneratePost(ctx, post, a.theme)
+	prompt, err := a.promptTemplate.Render(content.PromptData{
+		Theme:        a.theme,
+		SourceBody:   post.Content,
+		SourceAuthor: post.Author,
+		Platform:     post.Source,
+		MaxChars:     maxPostChars,
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to generate post content: %w", err)
+		return nil, fmt.Errorf("failed to render prompt: %w", err)
 	}
 
-	return &GeneratedPost{
-		Content:   socialContent,
-		CreatedAt: time.Now(),
-	}, nil
-}
-
-// TruncateForSocialMedia ensures the post fits within social media character limits (300 chars).
-func TruncateForSocialMedia(content string, maxChars int) string {
-	if len(content) <= maxChars {
-		return content
-	}
-
-	truncated := content[:maxChars]
-	for i := len(truncated) - 1; i >= 0; i-- {
-		if truncated[i] == ' ' {
-			return truncated[:i] + "..."
-		}
-	}
-
-	return truncated + "..."
-}
-
-// GeminiGenerator uses Google's Gemini to generate posts.
-type GeminiGenerator struct {
-	client *genai.Client
-}
-
-// GeneratePost creates a social media post from a Twitter/X post using Gemini.
-func (gg *GeminiGenerator) GeneratePost(ctx context.Context, post *twitter.Post, theme string) (string, error) {
-	if post == nil {
-		return "", fmt.Errorf("post is nil")
-	}
-
-	prompt := fmt.Sprintf(`You are a humorous social media content creator specializing in workplace frustration content. 
-Your task is to create an engaging social media post based on a Twitter/X work rant that embodies the theme: "%s"
-
-Twitter/X Post:
-%s
-
-Requirements:
-1. Transform the Twitter/X rant into a relatable, humorous social media post about workplace frustrations
-2. The post should be between 100-300 characters
-3. Use conversational, natural language appropriate for social media
-4. Incorporate subtle humor and frustration about office dynamics, coworkers, or work situations
-5. Make it engaging and likely to resonate with people frustrated at work
-6. Do NOT include hashtags unless they naturally fit
-7. Keep it authentic and relatable, not preachy
-8. Optionally include a mild question or observation that invites engagement
-
-Generate ONLY the post content, nothing else.`, theme, post.Content)
-
-	resp, err := gg.client.Models.GenerateContent(ctx, "gemini-2.5-flash", []*genai.Content{
-		{
-			Parts: []*genai.Part{
-				{Text: prompt},
-			},
-		},
-	}, nil)
+	socialContent, err := a.contentGen.GeneratePost(ctx, prompt)
 	if err != nil {
-		return "", fmt.Errorf("failed to call Gemini API: %w", err)
+		return nil, fmt.Errorf("failed to generate post content: %w", err)
 	}
 
-	if len(resp.Candidates) == 0 {
-		return "", fmt.Errorf("empty response from Gemini")
+	if content.CharCount(fanoutPlatform, socialContent) > maxPostChars {
+		socialContent = content.TruncateForPlatform(socialContent, fanoutPlatform)
 	}
 
-	var generatedPost string
-	if len(resp.Candidates[0].Content.Parts) > 0 {
-		if resp.Candidates[0].Content.Parts[0].Text != "" {
-			generatedPost = resp.Candidates[0].Content.Parts[0].Text
+	if a.validator != nil {
+		if err := a.validator.Validate(ctx, socialContent); err != nil {
+			return nil, fmt.Errorf("generated post failed validation: %w", err)
 		}
 	}
 
-	if generatedPost == "" {
-		return "", fmt.Errorf("no text content in Gemini response")
-	}
-
-	if len(generatedPost) > 500 {
-		generatedPost = TruncateForSocialMedia(generatedPost, 300)
-	}
-
-	return generatedPost, nil
+	return &GeneratedPost{
+		Content:   socialContent,
+		CreatedAt: time.Now(),
+	}, nil
 }
@@ -0,0 +1,42 @@
+package source
+
+import (
+	"context"
+
+	"social-agent/internal/agent"
+	"social-agent/internal/social/reddit"
+)
+
+// RedditSource adapts a reddit.ContentSource into a Source.
+type RedditSource struct {
+	Client reddit.ContentSource
+}
+
+// Name identifies this source as "reddit".
+func (s *RedditSource) Name() string { return "reddit" }
+
+// Fetch queries recent subreddit posts within q.MaxContentAgeDays.
+func (s *RedditSource) Fetch(ctx context.Context, q Query) ([]*agent.SourcePost, error) {
+	posts, err := s.Client.FetchRecent(ctx, q.MaxContentAgeDays)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*agent.SourcePost, 0, len(posts))
+	for _, post := range posts {
+		result = append(result, redditToSourcePost(post))
+	}
+
+	return result, nil
+}
+
+func redditToSourcePost(post *reddit.Post) *agent.SourcePost {
+	return &agent.SourcePost{
+		ID:        post.ID,
+		Content:   post.Title + "\n" + post.Content,
+		Author:    post.Author,
+		URL:       post.URL,
+		CreatedAt: post.CreatedAt,
+		Source:    post.Source,
+	}
+}
@@ -0,0 +1,107 @@
+// Package source generalizes "where does candidate content come from" so
+// the scheduler isn't hard-wired to Twitter and Reddit: each platform
+// (Twitter, Reddit, a Fediverse timeline, RSS, ...) implements Source, and
+// a Registry fans a fetch out across however many are configured, merging
+// the results into one candidate pool tagged by Post.Source. Adding a new
+// platform is a new Source implementation, not a scheduler change.
+package source
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+
+	"social-agent/config"
+	"social-agent/internal/agent"
+)
+
+// Query configures a single Fetch call against a Source.
+type Query struct {
+	// Limit is the maximum number of posts a Source should request from
+	// its underlying API in one call.
+	Limit int
+	// MaxContentAgeDays discards posts older than this; 0 means no age
+	// filtering.
+	MaxContentAgeDays int
+}
+
+// Source supplies candidate posts for the scheduler's post routine.
+type Source interface {
+	// Name identifies this source for per-source quotas and is stamped
+	// onto every returned post's Source field.
+	Name() string
+	Fetch(ctx context.Context, q Query) ([]*agent.SourcePost, error)
+}
+
+// Weighted pairs a Source with the maximum number of its candidates
+// FetchAll should keep before merging the pool, so one noisy source can't
+// crowd out the rest. A Quota of 0 means unlimited.
+type Weighted struct {
+	Source Source
+	Quota  int
+}
+
+// Registry holds the sources configured for one scheduler.
+type Registry struct {
+	sources []Weighted
+}
+
+// NewRegistry builds a Registry from sources, as configured by the caller
+// (e.g. from config-driven per-platform quotas).
+func NewRegistry(sources ...Weighted) *Registry {
+	return &Registry{sources: sources}
+}
+
+// NewRegistryFromConfig builds a Registry from sources, keyed by the same
+// name each Source.Name() returns, applying its quota from
+// cfg.SourceQuotas. A source with no entry in SourceQuotas is unlimited.
+func NewRegistryFromConfig(cfg *config.Config, sources map[string]Source) *Registry {
+	weighted := make([]Weighted, 0, len(sources))
+	for name, s := range sources {
+		weighted = append(weighted, Weighted{Source: s, Quota: cfg.SourceQuotas[name]})
+	}
+	return NewRegistry(weighted...)
+}
+
+// FetchAll fans q out to every registered source concurrently, applies
+// each source's quota, and merges the results into one pool. A source
+// whose Fetch call errors is logged and skipped rather than failing the
+// whole fetch.
+func (r *Registry) FetchAll(ctx context.Context, q Query) []*agent.SourcePost {
+	type result struct {
+		name  string
+		posts []*agent.SourcePost
+		err   error
+	}
+
+	results := make(chan result, len(r.sources))
+
+	var wg sync.WaitGroup
+	for _, w := range r.sources {
+		wg.Add(1)
+		go func(w Weighted) {
+			defer wg.Done()
+			posts, err := w.Source.Fetch(ctx, q)
+			if err == nil && w.Quota > 0 && len(posts) > w.Quota {
+				posts = posts[:w.Quota]
+			}
+			results <- result{name: w.Source.Name(), posts: posts, err: err}
+		}(w)
+	}
+
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	var merged []*agent.SourcePost
+	for res := range results {
+		if res.err != nil {
+			slog.Error("failed to fetch from content source", "source", res.name, "error", res.err)
+			continue
+		}
+		merged = append(merged, res.posts...)
+	}
+
+	return merged
+}
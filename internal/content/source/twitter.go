@@ -0,0 +1,61 @@
+package source
+
+import (
+	"context"
+	"time"
+
+	"social-agent/internal/agent"
+	"social-agent/internal/social/twitter"
+)
+
+// TwitterSource adapts a twitter.ContentSource into a Source.
+type TwitterSource struct {
+	Client twitter.ContentSource
+}
+
+// Name identifies this source as "twitter".
+func (s *TwitterSource) Name() string { return "twitter" }
+
+// Fetch queries recent work-rant tweets and discards anything older than
+// q.MaxContentAgeDays.
+func (s *TwitterSource) Fetch(ctx context.Context, q Query) ([]*agent.SourcePost, error) {
+	limit := q.Limit
+	if limit <= 0 {
+		limit = 3
+	}
+
+	tweets, err := s.Client.QueryWorkRantTweets(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	var cutoff time.Time
+	if q.MaxContentAgeDays > 0 {
+		cutoff = time.Now().AddDate(0, 0, -q.MaxContentAgeDays)
+	}
+
+	var posts []*agent.SourcePost
+	for i := range tweets {
+		if !cutoff.IsZero() && !tweets[i].CreatedAt.After(cutoff) {
+			continue
+		}
+		posts = append(posts, tweetToSourcePost(&tweets[i]))
+	}
+
+	return posts, nil
+}
+
+func tweetToSourcePost(post *twitter.Post) *agent.SourcePost {
+	src := post.Source
+	if src == "" {
+		src = "twitter"
+	}
+	return &agent.SourcePost{
+		ID:        post.ID,
+		Content:   post.Content,
+		Author:    post.Author,
+		URL:       post.URL,
+		CreatedAt: post.CreatedAt,
+		Source:    src,
+	}
+}
@@ -0,0 +1,226 @@
+// Package httpx provides a shared HTTP transport for the social clients
+// (Reddit, Twitter, Bluesky) that proactively throttles against rate limit
+// headers, retries 5xx/429 responses with exponential backoff and jitter,
+// and maps well-known status codes to typed sentinel errors.
+package httpx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Sentinel errors for well-known status codes, so callers can switch on
+// them instead of re-deriving meaning from a raw status code.
+var (
+	ErrAuthRevoked = errors.New("httpx: authentication revoked")
+	ErrNotFound    = errors.New("httpx: resource not found")
+	ErrRateLimited = errors.New("httpx: rate limited")
+)
+
+// RateLimitingInfo captures the rate limit headers returned with a
+// response, so callers (the scheduler) can throttle between requests
+// rather than hammering the API until it starts rejecting them. Remaining
+// is -1 when the response carried no rate limit headers at all.
+type RateLimitingInfo struct {
+	Remaining int
+	Used      int
+	Reset     time.Duration
+	Timestamp time.Time
+}
+
+// backoffSchedule is the fixed exponential backoff applied between retries
+// of 5xx/429 responses, before jitter is applied.
+var backoffSchedule = []time.Duration{
+	200 * time.Millisecond,
+	500 * time.Millisecond,
+	1 * time.Second,
+	2 * time.Second,
+}
+
+// maxElapsed caps the total time Do spends retrying a single request.
+const maxElapsed = 30 * time.Second
+
+// defaultMinRemaining is the default floor below which Do proactively
+// sleeps until the rate limit window resets, to avoid tripping into a 429.
+const defaultMinRemaining = 50
+
+// Transport wraps an *http.Client with rate-limit awareness, retry/backoff
+// on 5xx/429, and typed sentinel errors for well-known status codes.
+type Transport struct {
+	httpClient   *http.Client
+	MinRemaining int
+
+	// Sign, if set, is called on every attempt (including the first)
+	// before the request is sent, so a signing scheme whose signature
+	// embeds a nonce or timestamp (e.g. OAuth1) is recomputed for each
+	// retry instead of resending the first attempt's now-stale
+	// Authorization header.
+	Sign func(req *http.Request) error
+}
+
+// New creates a Transport around httpClient using the default rate limit
+// buffer (50 requests remaining).
+func New(httpClient *http.Client) *Transport {
+	return &Transport{
+		httpClient:   httpClient,
+		MinRemaining: defaultMinRemaining,
+	}
+}
+
+// Do sends req, retrying on 5xx/429 with exponential backoff and jitter
+// (capped at maxElapsed total wait) and proactively sleeping when the
+// response's rate limit headers show fewer than MinRemaining requests
+// left. Retry-After takes precedence over the fixed backoff schedule when
+// present. req must have a non-nil GetBody (set automatically by
+// http.NewRequest for bytes.Buffer/bytes.Reader/strings.Reader bodies, and
+// trivially satisfied for bodyless requests) so the request can be
+// resent on retry. If Sign is set, it (re-)signs every attempt, including
+// retries, rather than just the first.
+func (t *Transport) Do(ctx context.Context, req *http.Request) (*http.Response, []byte, RateLimitingInfo, error) {
+	var lastInfo RateLimitingInfo
+	start := time.Now()
+
+	for attempt := 0; ; attempt++ {
+		attemptReq := req.Clone(ctx)
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, nil, lastInfo, fmt.Errorf("httpx: failed to rewind request body: %w", err)
+			}
+			attemptReq.Body = io.NopCloser(body)
+		}
+
+		if t.Sign != nil {
+			if err := t.Sign(attemptReq); err != nil {
+				return nil, nil, lastInfo, fmt.Errorf("httpx: failed to sign request: %w", err)
+			}
+		}
+
+		resp, err := t.httpClient.Do(attemptReq)
+		if err != nil {
+			return nil, nil, lastInfo, fmt.Errorf("httpx: request failed: %w", err)
+		}
+
+		respBody, readErr := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if readErr != nil {
+			return nil, nil, lastInfo, fmt.Errorf("httpx: failed to read response body: %w", readErr)
+		}
+
+		lastInfo = parseRateLimitingInfo(resp.Header)
+
+		retryable := resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500
+		if !retryable {
+			if lastInfo.Remaining >= 0 && lastInfo.Remaining < t.minRemaining() {
+				sleep(ctx, lastInfo.Reset)
+			}
+			return resp, respBody, lastInfo, ClassifyStatus(resp.StatusCode)
+		}
+
+		wait := retryAfter(resp.Header)
+		if wait == 0 {
+			wait = jitter(backoffDelay(attempt))
+		}
+		if time.Since(start)+wait > maxElapsed {
+			return resp, respBody, lastInfo, fmt.Errorf("httpx: %w (gave up after %s)", ErrRateLimited, time.Since(start).Round(time.Millisecond))
+		}
+
+		slog.Warn("httpx: retrying request", "url", req.URL.String(), "status", resp.StatusCode, "attempt", attempt+1, "wait", wait)
+		select {
+		case <-ctx.Done():
+			return nil, nil, lastInfo, ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+}
+
+func (t *Transport) minRemaining() int {
+	if t.MinRemaining <= 0 {
+		return defaultMinRemaining
+	}
+	return t.MinRemaining
+}
+
+// ClassifyStatus maps well-known status codes to a typed sentinel error, or
+// nil for a successful or otherwise-unmapped status.
+func ClassifyStatus(statusCode int) error {
+	switch statusCode {
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return ErrAuthRevoked
+	case http.StatusNotFound:
+		return ErrNotFound
+	case http.StatusTooManyRequests:
+		return ErrRateLimited
+	default:
+		return nil
+	}
+}
+
+func parseRateLimitingInfo(h http.Header) RateLimitingInfo {
+	info := RateLimitingInfo{Remaining: -1, Timestamp: time.Now()}
+	if v := h.Get("x-ratelimit-remaining"); v != "" {
+		if f, err := strconv.ParseFloat(v, 64); err == nil {
+			info.Remaining = int(f)
+		}
+	}
+	if v := h.Get("x-ratelimit-used"); v != "" {
+		if f, err := strconv.ParseFloat(v, 64); err == nil {
+			info.Used = int(f)
+		}
+	}
+	if v := h.Get("x-ratelimit-reset"); v != "" {
+		if secs, err := strconv.ParseFloat(v, 64); err == nil {
+			info.Reset = time.Duration(secs * float64(time.Second))
+		}
+	}
+	return info
+}
+
+// retryAfter parses the Retry-After header as a number of seconds, per the
+// invariant that it takes precedence over the fixed backoff schedule. It
+// returns 0 if the header is absent or not a plain integer.
+func retryAfter(h http.Header) time.Duration {
+	v := h.Get("Retry-After")
+	if v == "" {
+		return 0
+	}
+	secs, err := strconv.Atoi(v)
+	if err != nil || secs < 0 {
+		return 0
+	}
+	return time.Duration(secs) * time.Second
+}
+
+func backoffDelay(attempt int) time.Duration {
+	if attempt >= len(backoffSchedule) {
+		attempt = len(backoffSchedule) - 1
+	}
+	return backoffSchedule[attempt]
+}
+
+// jitter adds up to +/-20% random variance to d so retries from multiple
+// callers don't land in lockstep.
+func jitter(d time.Duration) time.Duration {
+	spread := float64(d) * 0.2
+	offset := (rand.Float64()*2 - 1) * spread
+	return d + time.Duration(offset)
+}
+
+// sleep blocks for d (or until ctx is done, whichever comes first). It is a
+// no-op for non-positive durations.
+func sleep(ctx context.Context, d time.Duration) {
+	if d <= 0 {
+		return
+	}
+	select {
+	case <-ctx.Done():
+	case <-time.After(d):
+	}
+}
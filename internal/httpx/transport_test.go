@@ -0,0 +1,140 @@
+package httpx
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestDoRetriesOnServerErrorThenSucceeds(t *testing.T) {
+	var calls int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("ok"))
+	}))
+	defer srv.Close()
+
+	tr := New(srv.Client())
+	req, err := http.NewRequest(http.MethodGet, srv.URL, nil)
+	if err != nil {
+		t.Fatalf("failed to build request: %v", err)
+	}
+
+	resp, body, _, err := tr.Do(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Do() error = %v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("resp.StatusCode = %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+	if string(body) != "ok" {
+		t.Errorf("body = %q, want %q", body, "ok")
+	}
+	if calls != 3 {
+		t.Errorf("server was called %d times, want 3 (2 failures + 1 success)", calls)
+	}
+}
+
+func TestDoHonorsContextCancellationWhileRetryingRateLimit(t *testing.T) {
+	var calls int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("x-ratelimit-remaining", "0")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer srv.Close()
+
+	tr := New(srv.Client())
+	req, err := http.NewRequest(http.MethodGet, srv.URL, nil)
+	if err != nil {
+		t.Fatalf("failed to build request: %v", err)
+	}
+
+	// 429 is in the retryable set, so Do will keep retrying until
+	// maxElapsed; cap the test's patience with a short-lived context
+	// instead of waiting out the real 30s ceiling.
+	ctx, cancel := context.WithTimeout(context.Background(), 50_000_000) // 50ms
+	defer cancel()
+
+	_, _, _, err = tr.Do(ctx, req)
+	if err == nil {
+		t.Fatal("Do() with a context that expires mid-retry should return an error")
+	}
+	if calls == 0 {
+		t.Error("server was never called")
+	}
+}
+
+func TestDoClassifiesAuthRevoked(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer srv.Close()
+
+	tr := New(srv.Client())
+	req, err := http.NewRequest(http.MethodGet, srv.URL, nil)
+	if err != nil {
+		t.Fatalf("failed to build request: %v", err)
+	}
+
+	_, _, _, err = tr.Do(context.Background(), req)
+	if err != ErrAuthRevoked {
+		t.Fatalf("Do() error = %v, want %v", err, ErrAuthRevoked)
+	}
+}
+
+func TestDoInvokesSignOnEveryAttempt(t *testing.T) {
+	var calls int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 2 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	var signCalls int
+	tr := New(srv.Client())
+	tr.Sign = func(req *http.Request) error {
+		signCalls++
+		req.Header.Set("Authorization", "signed")
+		return nil
+	}
+
+	req, err := http.NewRequest(http.MethodGet, srv.URL, nil)
+	if err != nil {
+		t.Fatalf("failed to build request: %v", err)
+	}
+
+	if _, _, _, err := tr.Do(context.Background(), req); err != nil {
+		t.Fatalf("Do() error = %v", err)
+	}
+
+	if signCalls != calls {
+		t.Errorf("Sign was called %d times for %d attempts; it must run on every attempt, including retries", signCalls, calls)
+	}
+}
+
+func TestClassifyStatus(t *testing.T) {
+	cases := map[int]error{
+		http.StatusUnauthorized:        ErrAuthRevoked,
+		http.StatusForbidden:           ErrAuthRevoked,
+		http.StatusNotFound:            ErrNotFound,
+		http.StatusTooManyRequests:     ErrRateLimited,
+		http.StatusOK:                  nil,
+		http.StatusInternalServerError: nil,
+	}
+	for status, want := range cases {
+		if got := ClassifyStatus(status); got != want {
+			t.Errorf("ClassifyStatus(%d) = %v, want %v", status, got, want)
+		}
+	}
+}
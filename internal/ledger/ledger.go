@@ -0,0 +1,233 @@
+// Package ledger records every GeneratedPost before it is dispatched to any
+// ContentDestination, so restarts and retries don't cause duplicate posting.
+// Entries are keyed by ULID (github.com/oklog/ulid/v2), which sorts
+// lexicographically by creation time, letting the ledger double as an
+// append-only audit log that supports range scans by time window.
+package ledger
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	entriesBucket    = "entries"
+	bySourceBucket   = "by_source"
+	byDestHashBucket = "by_dest_hash"
+)
+
+// Entry is a single recorded ledger event: a generated post either about to
+// be, or already, dispatched to a destination.
+type Entry struct {
+	ID          string    `json:"id"`
+	SourceID    string    `json:"source_id"`
+	ContentHash string    `json:"content_hash"`
+	Destination string    `json:"destination"`
+	URI         string    `json:"uri"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// Ledger is a BoltDB-backed append-only store of dispatched posts.
+type Ledger struct {
+	db *bolt.DB
+}
+
+// Open opens (creating if necessary) a ledger file at path, the value of
+// LEDGER_PATH in production.
+func Open(path string) (*Ledger, error) {
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open ledger at %s: %w", path, err)
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		for _, bucket := range []string{entriesBucket, bySourceBucket, byDestHashBucket} {
+			if _, err := tx.CreateBucketIfNotExists([]byte(bucket)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to initialize ledger buckets: %w", err)
+	}
+
+	return &Ledger{db: db}, nil
+}
+
+// Close releases the underlying ledger file.
+func (l *Ledger) Close() error {
+	return l.db.Close()
+}
+
+// HashContent returns the stable SHA-256 hex digest used to identify
+// generated content, independent of which destination it is posted to.
+func HashContent(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
+
+// SeenSource reports whether a source post has already been consumed by the
+// agent, so the scheduler can skip asking Gemini to regenerate it.
+func (l *Ledger) SeenSource(sourceID string) (bool, error) {
+	var seen bool
+	err := l.db.View(func(tx *bolt.Tx) error {
+		seen = tx.Bucket([]byte(bySourceBucket)).Get([]byte(sourceID)) != nil
+		return nil
+	})
+	return seen, err
+}
+
+// URIForSource returns the destination URI of the most recent entry
+// recorded against sourceID, so callers that only know a source post (e.g.
+// a watcher matching a Reddit post) can find what it was actually published
+// as. The second return value is false if sourceID has no recorded entry.
+func (l *Ledger) URIForSource(sourceID string) (string, bool, error) {
+	var uri string
+	var found bool
+	err := l.db.View(func(tx *bolt.Tx) error {
+		id := tx.Bucket([]byte(bySourceBucket)).Get([]byte(sourceID))
+		if id == nil {
+			return nil
+		}
+		raw := tx.Bucket([]byte(entriesBucket)).Get(id)
+		if raw == nil {
+			return nil
+		}
+		var entry Entry
+		if err := json.Unmarshal(raw, &entry); err != nil {
+			return err
+		}
+		uri = entry.URI
+		found = true
+		return nil
+	})
+	return uri, found, err
+}
+
+// SeenContent reports whether content has already been posted to
+// destination, guarding against re-posts caused by restarts or retries.
+func (l *Ledger) SeenContent(destination, content string) (bool, error) {
+	key := destHashKey(destination, HashContent(content))
+
+	var seen bool
+	err := l.db.View(func(tx *bolt.Tx) error {
+		seen = tx.Bucket([]byte(byDestHashBucket)).Get(key) != nil
+		return nil
+	})
+	return seen, err
+}
+
+// Record appends a new entry to the ledger and marks sourceID and
+// (destination, content) as consumed.
+func (l *Ledger) Record(sourceID, content, destination, uri string) (Entry, error) {
+	id := ulid.MustNew(ulid.Timestamp(time.Now()), rand.Reader)
+
+	entry := Entry{
+		ID:          id.String(),
+		SourceID:    sourceID,
+		ContentHash: HashContent(content),
+		Destination: destination,
+		URI:         uri,
+		CreatedAt:   time.Now().UTC(),
+	}
+
+	value, err := json.Marshal(entry)
+	if err != nil {
+		return Entry{}, fmt.Errorf("failed to marshal ledger entry: %w", err)
+	}
+
+	err = l.db.Update(func(tx *bolt.Tx) error {
+		if err := tx.Bucket([]byte(entriesBucket)).Put(id[:], value); err != nil {
+			return err
+		}
+		if sourceID != "" {
+			if err := tx.Bucket([]byte(bySourceBucket)).Put([]byte(sourceID), id[:]); err != nil {
+				return err
+			}
+		}
+		return tx.Bucket([]byte(byDestHashBucket)).Put(destHashKey(destination, entry.ContentHash), id[:])
+	})
+	if err != nil {
+		return Entry{}, fmt.Errorf("failed to write ledger entry: %w", err)
+	}
+
+	return entry, nil
+}
+
+// Range returns every entry created within [since, until), relying on the
+// lexicographic ordering of ULIDs to make this a cheap bucket scan rather
+// than a secondary time index.
+func (l *Ledger) Range(since, until time.Time) ([]Entry, error) {
+	lower := ulid.MustNew(ulid.Timestamp(since), zeroEntropy{})
+	upper := ulid.MustNew(ulid.Timestamp(until), zeroEntropy{})
+
+	var entries []Entry
+	err := l.db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket([]byte(entriesBucket)).Cursor()
+		for k, v := c.Seek(lower[:]); k != nil && string(k) < string(upper[:]); k, v = c.Next() {
+			var entry Entry
+			if err := json.Unmarshal(v, &entry); err != nil {
+				return err
+			}
+			entries = append(entries, entry)
+		}
+		return nil
+	})
+
+	return entries, err
+}
+
+// Prune removes every ledger entry older than maxAge, used to enforce
+// MaxContentAgeDays without a separate time index.
+func (l *Ledger) Prune(maxAge time.Duration) (int, error) {
+	cutoff := ulid.MustNew(ulid.Timestamp(time.Now().Add(-maxAge)), zeroEntropy{})
+
+	removed := 0
+	err := l.db.Update(func(tx *bolt.Tx) error {
+		entries := tx.Bucket([]byte(entriesBucket))
+		c := entries.Cursor()
+
+		var stale [][]byte
+		for k, _ := c.First(); k != nil; k, _ = c.Next() {
+			if string(k) < string(cutoff[:]) {
+				stale = append(stale, append([]byte(nil), k...))
+			}
+		}
+
+		for _, key := range stale {
+			if err := entries.Delete(key); err != nil {
+				return err
+			}
+			removed++
+		}
+
+		return nil
+	})
+
+	return removed, err
+}
+
+func destHashKey(destination, hash string) []byte {
+	return []byte(destination + ":" + hash)
+}
+
+// zeroEntropy produces all-zero entropy bytes, used when building a ULID
+// purely for its timestamp-ordered key (range/prune bounds), where the
+// random component doesn't matter.
+type zeroEntropy struct{}
+
+func (zeroEntropy) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = 0
+	}
+	return len(p), nil
+}
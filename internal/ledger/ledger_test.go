@@ -0,0 +1,116 @@
+package ledger
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func openTestLedger(t *testing.T) *Ledger {
+	t.Helper()
+	l, err := Open(filepath.Join(t.TempDir(), "ledger.db"))
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	t.Cleanup(func() { l.Close() })
+	return l
+}
+
+func TestRecordAndSeenSource(t *testing.T) {
+	l := openTestLedger(t)
+
+	if seen, err := l.SeenSource("reddit:abc"); err != nil || seen {
+		t.Fatalf("SeenSource() before Record = (%v, %v), want (false, nil)", seen, err)
+	}
+
+	entry, err := l.Record("reddit:abc", "hello world", "bluesky", "at://did:example/post/1")
+	if err != nil {
+		t.Fatalf("Record() error = %v", err)
+	}
+	if entry.ID == "" {
+		t.Error("Record() returned an entry with an empty ID")
+	}
+
+	seen, err := l.SeenSource("reddit:abc")
+	if err != nil || !seen {
+		t.Fatalf("SeenSource() after Record = (%v, %v), want (true, nil)", seen, err)
+	}
+}
+
+func TestSeenContentIsPerDestination(t *testing.T) {
+	l := openTestLedger(t)
+
+	if _, err := l.Record("src-1", "duplicate text", "bluesky", "uri-1"); err != nil {
+		t.Fatalf("Record() error = %v", err)
+	}
+
+	seenBluesky, err := l.SeenContent("bluesky", "duplicate text")
+	if err != nil || !seenBluesky {
+		t.Fatalf("SeenContent(bluesky) = (%v, %v), want (true, nil)", seenBluesky, err)
+	}
+
+	seenMastodon, err := l.SeenContent("mastodon", "duplicate text")
+	if err != nil || seenMastodon {
+		t.Fatalf("SeenContent(mastodon) = (%v, %v), want (false, nil): same content on an untouched destination should not count as seen", seenMastodon, err)
+	}
+}
+
+func TestURIForSourceReturnsMostRecentEntry(t *testing.T) {
+	l := openTestLedger(t)
+
+	if _, _, err := l.URIForSource("missing"); err != nil {
+		t.Fatalf("URIForSource() error = %v", err)
+	}
+	if _, found, err := l.URIForSource("missing"); err != nil || found {
+		t.Fatalf("URIForSource(missing) found = %v, err = %v, want (false, nil)", found, err)
+	}
+
+	if _, err := l.Record("src-1", "text", "bluesky", "uri-A"); err != nil {
+		t.Fatalf("Record() error = %v", err)
+	}
+	if _, err := l.Record("src-1", "text v2", "bluesky", "uri-B"); err != nil {
+		t.Fatalf("Record() error = %v", err)
+	}
+
+	uri, found, err := l.URIForSource("src-1")
+	if err != nil {
+		t.Fatalf("URIForSource() error = %v", err)
+	}
+	if !found || uri != "uri-B" {
+		t.Errorf("URIForSource(src-1) = (%q, %v), want (%q, true) - the latest recorded entry", uri, found, "uri-B")
+	}
+}
+
+func TestRangeReturnsEntriesWithinWindow(t *testing.T) {
+	l := openTestLedger(t)
+
+	now := time.Now()
+	if _, err := l.Record("src-old", "old", "bluesky", "uri-old"); err != nil {
+		t.Fatalf("Record() error = %v", err)
+	}
+
+	entries, err := l.Range(now.Add(-time.Hour), now.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("Range() error = %v", err)
+	}
+	if len(entries) != 1 || entries[0].SourceID != "src-old" {
+		t.Errorf("Range() = %+v, want exactly the entry just recorded", entries)
+	}
+
+	entries, err = l.Range(now.Add(time.Hour), now.Add(2*time.Hour))
+	if err != nil {
+		t.Fatalf("Range() error = %v", err)
+	}
+	if len(entries) != 0 {
+		t.Errorf("Range() over a future window = %+v, want empty", entries)
+	}
+}
+
+func TestHashContentIsStableAndDistinct(t *testing.T) {
+	if HashContent("a") != HashContent("a") {
+		t.Error("HashContent() is not stable for identical input")
+	}
+	if HashContent("a") == HashContent("b") {
+		t.Error("HashContent() collided for distinct input")
+	}
+}
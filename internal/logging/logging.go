@@ -0,0 +1,130 @@
+// Package logging builds the application's slog.Logger from config,
+// replacing the ad-hoc printf-style internal.Logger with structured,
+// leveled logging that the rest of the codebase (which already calls
+// log/slog directly) expects.
+package logging
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+// Config configures the slog.Logger returned by New.
+type Config struct {
+	// Level is the default minimum level: "debug", "info", "warn", or
+	// "error". Defaults to "info" if empty or unrecognized.
+	Level string
+	// Format selects the handler: "json" or "text" (default "text").
+	Format string
+	// ModuleLevels overrides Level for specific "module" attributes (see
+	// WithModule), e.g. {"twitter": "debug", "scheduler": "warn"}.
+	ModuleLevels map[string]string
+	// FilePath, if set, writes logs to a rotating file instead of stdout.
+	FilePath string
+}
+
+// New builds a *slog.Logger from cfg and installs it as the slog default,
+// so every package that calls the top-level slog.Info/Warn/Error functions
+// picks it up without further wiring.
+func New(cfg Config) *slog.Logger {
+	var w io.Writer = os.Stdout
+	if cfg.FilePath != "" {
+		w = &lumberjack.Logger{
+			Filename:   cfg.FilePath,
+			MaxSize:    100, // megabytes
+			MaxBackups: 3,
+			MaxAge:     28, // days
+		}
+	}
+
+	defaultLevel := parseLevel(cfg.Level)
+	moduleLevels := make(map[string]slog.Level, len(cfg.ModuleLevels))
+	for module, level := range cfg.ModuleLevels {
+		moduleLevels[module] = parseLevel(level)
+	}
+
+	// The wrapping handler decides Enabled itself, so the base handler must
+	// not filter anything out first.
+	opts := &slog.HandlerOptions{Level: slog.LevelDebug}
+	var base slog.Handler
+	if strings.EqualFold(cfg.Format, "json") {
+		base = slog.NewJSONHandler(w, opts)
+	} else {
+		base = slog.NewTextHandler(w, opts)
+	}
+
+	handler := &moduleLevelHandler{
+		Handler:      base,
+		defaultLevel: defaultLevel,
+		moduleLevels: moduleLevels,
+	}
+
+	logger := slog.New(handler)
+	slog.SetDefault(logger)
+	return logger
+}
+
+// WithModule returns a logger annotated with a "module" attribute, so
+// Config.ModuleLevels can route its level independently of the default.
+func WithModule(logger *slog.Logger, module string) *slog.Logger {
+	return logger.With("module", module)
+}
+
+// WithRoutine returns a logger annotated with "module" and "routine"
+// attributes, for scheduler routines (post creation, follow, like, ...) to
+// tag every log line they emit over the course of a run.
+func WithRoutine(logger *slog.Logger, module, routine string) *slog.Logger {
+	return logger.With("module", module, "routine", routine)
+}
+
+func parseLevel(level string) slog.Level {
+	switch strings.ToLower(level) {
+	case "debug":
+		return slog.LevelDebug
+	case "warn":
+		return slog.LevelWarn
+	case "error":
+		return slog.LevelError
+	default:
+		return slog.LevelInfo
+	}
+}
+
+// moduleLevelHandler wraps a base slog.Handler, checking the "module"
+// attribute attached via WithModule/WithRoutine against Config.ModuleLevels
+// before falling back to the handler's default level.
+type moduleLevelHandler struct {
+	slog.Handler
+	defaultLevel slog.Level
+	moduleLevels map[string]slog.Level
+	module       string
+}
+
+func (h *moduleLevelHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	if lvl, ok := h.moduleLevels[h.module]; ok {
+		return level >= lvl
+	}
+	return level >= h.defaultLevel
+}
+
+func (h *moduleLevelHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	next := *h
+	next.Handler = h.Handler.WithAttrs(attrs)
+	for _, attr := range attrs {
+		if attr.Key == "module" {
+			next.module = attr.Value.String()
+		}
+	}
+	return &next
+}
+
+func (h *moduleLevelHandler) WithGroup(name string) slog.Handler {
+	next := *h
+	next.Handler = h.Handler.WithGroup(name)
+	return &next
+}
@@ -0,0 +1,230 @@
+package pubsub
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	outboxBucket = "outbox"
+	dlqBucket    = "dlq"
+
+	// MaxAttempts is how many times ChannelBus delivers a message that keeps
+	// being nacked (the first delivery plus MaxAttempts-1 retries) before
+	// moving it to the dead-letter queue.
+	MaxAttempts = 5
+
+	// subscriberBuffer is the channel depth handed to each Subscribe call;
+	// Publish blocks past this depth rather than dropping messages, so a
+	// slow subscriber applies backpressure instead of losing work.
+	subscriberBuffer = 64
+)
+
+// ChannelBus is the default Bus: an in-process fan-out over Go channels,
+// backed by a bbolt outbox so a message survives from Publish until every
+// subscriber has Ack'd it, even across a process restart.
+type ChannelBus struct {
+	db *bolt.DB
+
+	mu   sync.Mutex
+	subs map[string][]chan Delivery
+}
+
+var _ Bus = (*ChannelBus)(nil)
+
+// OpenChannelBus opens (creating if necessary) a bbolt-backed outbox at
+// path and returns a ready-to-use ChannelBus. Any message left in the
+// outbox from a prior run is replayed to each Subscribe call for its
+// topic.
+func OpenChannelBus(path string) (*ChannelBus, error) {
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open pubsub outbox at %s: %w", path, err)
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		for _, bucket := range []string{outboxBucket, dlqBucket} {
+			if _, err := tx.CreateBucketIfNotExists([]byte(bucket)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to initialize pubsub outbox buckets: %w", err)
+	}
+
+	return &ChannelBus{db: db, subs: make(map[string][]chan Delivery)}, nil
+}
+
+// Close releases the underlying outbox file.
+func (b *ChannelBus) Close() error {
+	return b.db.Close()
+}
+
+// Publish durably records a message for topic, then fans it out to every
+// subscriber currently registered for topic.
+func (b *ChannelBus) Publish(ctx context.Context, topic string, payload []byte) error {
+	msg := Message{
+		ID:        ulid.MustNew(ulid.Timestamp(time.Now()), rand.Reader).String(),
+		Topic:     topic,
+		Payload:   payload,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	if err := b.store(msg); err != nil {
+		return fmt.Errorf("failed to persist message to outbox: %w", err)
+	}
+
+	b.dispatch(ctx, msg)
+	return nil
+}
+
+// Subscribe returns a channel of Deliveries for topic, first replaying any
+// message already in the outbox for topic (e.g. left unacknowledged by a
+// prior run), then every message subsequently published to it.
+func (b *ChannelBus) Subscribe(ctx context.Context, topic string) (<-chan Delivery, error) {
+	ch := make(chan Delivery, subscriberBuffer)
+
+	b.mu.Lock()
+	b.subs[topic] = append(b.subs[topic], ch)
+	b.mu.Unlock()
+
+	pending, err := b.pending(topic)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load pending outbox messages for topic %q: %w", topic, err)
+	}
+
+	go func() {
+		for _, msg := range pending {
+			b.deliverTo(ctx, ch, msg)
+		}
+	}()
+
+	return ch, nil
+}
+
+func (b *ChannelBus) dispatch(ctx context.Context, msg Message) {
+	b.mu.Lock()
+	subs := append([]chan Delivery(nil), b.subs[msg.Topic]...)
+	b.mu.Unlock()
+
+	for _, ch := range subs {
+		b.deliverTo(ctx, ch, msg)
+	}
+}
+
+func (b *ChannelBus) deliverTo(ctx context.Context, ch chan Delivery, msg Message) {
+	delivery := Delivery{
+		Message: msg,
+		ack:     func() { b.ack(msg) },
+		nack:    func() { b.nack(msg) },
+	}
+
+	select {
+	case ch <- delivery:
+	case <-ctx.Done():
+	}
+}
+
+func (b *ChannelBus) ack(msg Message) {
+	err := b.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(outboxBucket)).Delete([]byte(msg.ID))
+	})
+	if err != nil {
+		slog.Error("failed to ack pubsub message", "topic", msg.Topic, "id", msg.ID, "error", err)
+	}
+}
+
+func (b *ChannelBus) nack(msg Message) {
+	msg.Attempts++
+
+	if msg.Attempts >= MaxAttempts {
+		b.deadLetter(msg)
+		return
+	}
+
+	if err := b.store(msg); err != nil {
+		slog.Error("failed to requeue nacked pubsub message", "topic", msg.Topic, "id", msg.ID, "error", err)
+		return
+	}
+
+	slog.Warn("requeuing nacked pubsub message", "topic", msg.Topic, "id", msg.ID, "attempts", msg.Attempts)
+	b.dispatch(context.Background(), msg)
+}
+
+func (b *ChannelBus) deadLetter(msg Message) {
+	slog.Error("pubsub message exceeded max attempts, moving to dead-letter queue", "topic", msg.Topic, "id", msg.ID, "attempts", msg.Attempts)
+
+	value, err := json.Marshal(msg)
+	if err != nil {
+		slog.Error("failed to marshal dead-lettered message", "topic", msg.Topic, "id", msg.ID, "error", err)
+		return
+	}
+
+	err = b.db.Update(func(tx *bolt.Tx) error {
+		if err := tx.Bucket([]byte(dlqBucket)).Put([]byte(msg.ID), value); err != nil {
+			return err
+		}
+		return tx.Bucket([]byte(outboxBucket)).Delete([]byte(msg.ID))
+	})
+	if err != nil {
+		slog.Error("failed to move message to dead-letter queue", "topic", msg.Topic, "id", msg.ID, "error", err)
+	}
+}
+
+// DeadLettered returns every message for topic that exhausted MaxAttempts,
+// for operator inspection/replay.
+func (b *ChannelBus) DeadLettered(topic string) ([]Message, error) {
+	var messages []Message
+	err := b.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(dlqBucket)).ForEach(func(_, v []byte) error {
+			var msg Message
+			if err := json.Unmarshal(v, &msg); err != nil {
+				return err
+			}
+			if msg.Topic == topic {
+				messages = append(messages, msg)
+			}
+			return nil
+		})
+	})
+	return messages, err
+}
+
+func (b *ChannelBus) store(msg Message) error {
+	value, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("failed to marshal message: %w", err)
+	}
+
+	return b.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(outboxBucket)).Put([]byte(msg.ID), value)
+	})
+}
+
+func (b *ChannelBus) pending(topic string) ([]Message, error) {
+	var messages []Message
+	err := b.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(outboxBucket)).ForEach(func(_, v []byte) error {
+			var msg Message
+			if err := json.Unmarshal(v, &msg); err != nil {
+				return err
+			}
+			if msg.Topic == topic {
+				messages = append(messages, msg)
+			}
+			return nil
+		})
+	})
+	return messages, err
+}
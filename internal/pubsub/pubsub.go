@@ -0,0 +1,71 @@
+// Package pubsub decouples content discovery, generation, and posting so
+// each stage can be rate-limited, retried, and restarted independently
+// instead of running inline as one synchronous call chain. It defines the
+// Publisher/Subscriber contract the scheduler's workers are written
+// against; ChannelBus is the default in-process implementation, backed by a
+// bbolt outbox so undelivered and unacknowledged messages survive a
+// restart.
+package pubsub
+
+import (
+	"context"
+	"time"
+)
+
+// Well-known topics shared by the scheduler's discovery, generation, and
+// posting workers.
+const (
+	TopicContentDiscovered = "content.discovered"
+	TopicContentGenerated  = "content.generated"
+	TopicPostCreated       = "post.created"
+	TopicPostFailed        = "post.failed"
+)
+
+// Message is a single published event. Payload is opaque to the bus; each
+// topic's publisher and subscribers agree on a JSON schema for it.
+type Message struct {
+	ID        string    `json:"id"`
+	Topic     string    `json:"topic"`
+	Payload   []byte    `json:"payload"`
+	CreatedAt time.Time `json:"created_at"`
+	Attempts  int       `json:"attempts"`
+}
+
+// Delivery wraps a Message handed to a subscriber with the at-least-once
+// acknowledgement it must call exactly one of: Ack once it has durably
+// handled the message (e.g. published its follow-on event), or Nack if
+// processing failed and the message should be retried or dead-lettered.
+type Delivery struct {
+	Message Message
+
+	ack  func()
+	nack func()
+}
+
+// Ack marks the delivery as successfully processed, removing it from the
+// bus's outbox so it is not redelivered after a restart.
+func (d Delivery) Ack() { d.ack() }
+
+// Nack marks the delivery as failed. The bus retries it (subject to its own
+// backoff/max-attempts policy) or moves it to a dead-letter queue.
+func (d Delivery) Nack() { d.nack() }
+
+// Publisher publishes payload to topic. Publish returns once the message is
+// durably recorded, not once every subscriber has processed it.
+type Publisher interface {
+	Publish(ctx context.Context, topic string, payload []byte) error
+}
+
+// Subscriber delivers every message published to topic, including any
+// persisted but unacknowledged from before the calling process started.
+type Subscriber interface {
+	Subscribe(ctx context.Context, topic string) (<-chan Delivery, error)
+}
+
+// Bus is the full Publisher/Subscriber contract a scheduler worker is
+// written against; ChannelBus and WatermillBus both implement it.
+type Bus interface {
+	Publisher
+	Subscriber
+	Close() error
+}
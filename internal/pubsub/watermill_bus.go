@@ -0,0 +1,101 @@
+package pubsub
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ThreeDotsLabs/watermill"
+	"github.com/ThreeDotsLabs/watermill-nats/v2/pkg/nats"
+	"github.com/ThreeDotsLabs/watermill/message"
+)
+
+// WatermillBus is an optional Bus backed by NATS JetStream via Watermill,
+// for deployments that already run a shared message broker and want the
+// scheduler's pipeline to participate in it rather than keep its own
+// per-process bbolt outbox. It mirrors the Redmage refactor this package is
+// modeled after. Message.Attempts/dead-lettering is left to JetStream's own
+// redelivery and max-deliver policy rather than reimplemented here.
+type WatermillBus struct {
+	publisher  message.Publisher
+	subscriber message.Subscriber
+}
+
+var _ Bus = (*WatermillBus)(nil)
+
+// NewWatermillBus connects to the NATS JetStream server at natsURL using
+// durable, at-least-once subscriptions so messages survive a subscriber
+// restart.
+func NewWatermillBus(natsURL string) (*WatermillBus, error) {
+	logger := watermill.NewSlogLogger(nil)
+
+	marshaler := &nats.GobMarshaler{}
+
+	publisher, err := nats.NewPublisher(nats.PublisherConfig{
+		URL:       natsURL,
+		Marshaler: marshaler,
+		JetStream: nats.JetStreamConfig{Disabled: false},
+	}, logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create NATS publisher: %w", err)
+	}
+
+	subscriber, err := nats.NewSubscriber(nats.SubscriberConfig{
+		URL:         natsURL,
+		Unmarshaler: marshaler,
+		JetStream:   nats.JetStreamConfig{Disabled: false},
+	}, logger)
+	if err != nil {
+		publisher.Close()
+		return nil, fmt.Errorf("failed to create NATS subscriber: %w", err)
+	}
+
+	return &WatermillBus{publisher: publisher, subscriber: subscriber}, nil
+}
+
+// Close releases the underlying NATS connections.
+func (b *WatermillBus) Close() error {
+	if err := b.publisher.Close(); err != nil {
+		return err
+	}
+	return b.subscriber.Close()
+}
+
+// Publish sends payload as a single Watermill message on topic.
+func (b *WatermillBus) Publish(ctx context.Context, topic string, payload []byte) error {
+	msg := message.NewMessage(watermill.NewULID(), payload)
+	return b.publisher.Publish(topic, msg)
+}
+
+// Subscribe adapts Watermill's message.Messages channel to pubsub.Delivery,
+// translating Watermill's own Ack/Nack into ours.
+func (b *WatermillBus) Subscribe(ctx context.Context, topic string) (<-chan Delivery, error) {
+	messages, err := b.subscriber.Subscribe(ctx, topic)
+	if err != nil {
+		return nil, fmt.Errorf("failed to subscribe to topic %q: %w", topic, err)
+	}
+
+	out := make(chan Delivery)
+	go func() {
+		defer close(out)
+		for msg := range messages {
+			msg := msg
+			delivery := Delivery{
+				Message: Message{
+					ID:      msg.UUID,
+					Topic:   topic,
+					Payload: msg.Payload,
+				},
+				ack:  func() { msg.Ack() },
+				nack: func() { msg.Nack() },
+			}
+
+			select {
+			case out <- delivery:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out, nil
+}
@@ -0,0 +1,161 @@
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// AdminServer exposes an HTTP API for managing a Scheduler's jobs at
+// runtime, so posting, follow, and like schedules can be added or removed
+// per subreddit or theme without restarting the agent. It also exposes the
+// scheduler's live activity, so operators don't have to tail logs to see
+// what the autonomous agent is doing:
+//
+//	GET    /jobs      list every job
+//	POST   /jobs      add a job (body is a Job; ID is assigned if empty)
+//	PUT    /jobs/{id} update a job
+//	DELETE /jobs/{id} remove a job
+//	GET    /events    Server-Sent Events stream of Scheduler.Events()
+//	GET    /metrics   Prometheus counters, one per EventType
+type AdminServer struct {
+	scheduler *Scheduler
+	server    *http.Server
+}
+
+// NewAdminServer builds an AdminServer listening on addr (e.g. ":8090").
+// Call ListenAndServe to start it.
+func NewAdminServer(scheduler *Scheduler, addr string) *AdminServer {
+	as := &AdminServer{scheduler: scheduler}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/jobs", as.handleJobs)
+	mux.HandleFunc("/jobs/", as.handleJob)
+	mux.HandleFunc("/events", as.handleEvents)
+	mux.Handle("/metrics", promhttp.Handler())
+
+	as.server = &http.Server{Addr: addr, Handler: mux}
+	return as
+}
+
+// ListenAndServe starts the admin HTTP server, blocking until it stops.
+func (as *AdminServer) ListenAndServe() error {
+	slog.Info("starting scheduler admin API", "addr", as.server.Addr)
+	return as.server.ListenAndServe()
+}
+
+// Shutdown gracefully stops the admin HTTP server.
+func (as *AdminServer) Shutdown(ctx context.Context) error {
+	return as.server.Shutdown(ctx)
+}
+
+func (as *AdminServer) handleJobs(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		jobs, err := as.scheduler.ListJobs()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		writeJSON(w, http.StatusOK, jobs)
+	case http.MethodPost:
+		var job Job
+		if err := json.NewDecoder(r.Body).Decode(&job); err != nil {
+			http.Error(w, fmt.Sprintf("invalid job payload: %v", err), http.StatusBadRequest)
+			return
+		}
+		added, err := as.scheduler.AddJob(job)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		writeJSON(w, http.StatusCreated, added)
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func (as *AdminServer) handleJob(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimPrefix(r.URL.Path, "/jobs/")
+	if id == "" {
+		http.Error(w, "missing job id", http.StatusBadRequest)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodPut:
+		var job Job
+		if err := json.NewDecoder(r.Body).Decode(&job); err != nil {
+			http.Error(w, fmt.Sprintf("invalid job payload: %v", err), http.StatusBadRequest)
+			return
+		}
+		job.ID = id
+		if err := as.scheduler.UpdateJob(job); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		writeJSON(w, http.StatusOK, job)
+	case http.MethodDelete:
+		if err := as.scheduler.RemoveJob(id); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// handleEvents streams Scheduler.Events() to the client as Server-Sent
+// Events until the request's context is cancelled (e.g. the client
+// disconnects), so `curl -N /events` shows live scheduler activity.
+func (as *AdminServer) handleEvents(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming not supported", http.StatusInternalServerError)
+		return
+	}
+
+	events, unsubscribe := as.scheduler.Events()
+	defer unsubscribe()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case evt, ok := <-events:
+			if !ok {
+				return
+			}
+			payload, err := json.Marshal(evt)
+			if err != nil {
+				slog.Error("failed to marshal event for SSE", "error", err)
+				continue
+			}
+			fmt.Fprintf(w, "event: %s\ndata: %s\n\n", evt.Type, payload)
+			flusher.Flush()
+		}
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,114 @@
+package scheduler
+
+import (
+	cryptorand "crypto/rand"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+)
+
+// EventType identifies the kind of activity an Event describes, mirroring
+// the routine/outcome pairs already logged via slog (see postRoutine,
+// followRoutine, likeRoutine) so a live subscriber sees exactly what the
+// logs show, just structured and without tailing a file.
+type EventType string
+
+const (
+	EventRoutineStarted  EventType = "routine_started"
+	EventPostGenerated   EventType = "post_generated"
+	EventPostPublished   EventType = "post_published"
+	EventPostFailed      EventType = "post_failed"
+	EventFollowSucceeded EventType = "follow_succeeded"
+	EventFollowFailed    EventType = "follow_failed"
+	EventLikeSucceeded   EventType = "like_succeeded"
+	EventLikeFailed      EventType = "like_failed"
+)
+
+// Event is a single unit of scheduler activity, broadcast to every Events()
+// subscriber. CorrelationID ties together the events emitted by a single
+// routine run (e.g. the PostGenerated and PostPublished/PostFailed events
+// produced by one postRoutine invocation without a bus). With a bus, the
+// generator and publisher workers use the source post's ID as
+// CorrelationID instead, so their events do not share the ID of the
+// RoutineStarted event that discovered the post.
+type Event struct {
+	Type          EventType      `json:"type"`
+	Time          time.Time      `json:"time"`
+	CorrelationID string         `json:"correlation_id"`
+	Message       string         `json:"message"`
+	Data          map[string]any `json:"data,omitempty"`
+}
+
+// eventRelay fans Event values out to every subscribed channel, borrowing
+// the broadcast/live-activity relay pattern used elsewhere for long-running
+// job progress. A subscriber that isn't keeping up has events dropped for
+// it rather than blocking the routine that emitted them.
+type eventRelay struct {
+	mu   sync.Mutex
+	subs map[chan Event]struct{}
+}
+
+func newEventRelay() *eventRelay {
+	return &eventRelay{subs: make(map[chan Event]struct{})}
+}
+
+// subscribe registers a new subscriber channel and returns it along with a
+// func to unsubscribe and release it. Callers must call the returned func
+// once done reading to avoid leaking the channel.
+func (r *eventRelay) subscribe() (<-chan Event, func()) {
+	ch := make(chan Event, 32)
+
+	r.mu.Lock()
+	r.subs[ch] = struct{}{}
+	r.mu.Unlock()
+
+	unsubscribe := func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		if _, ok := r.subs[ch]; ok {
+			delete(r.subs, ch)
+			close(ch)
+		}
+	}
+
+	return ch, unsubscribe
+}
+
+func (r *eventRelay) publish(evt Event) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for ch := range r.subs {
+		select {
+		case ch <- evt:
+		default:
+			slog.Warn("dropping scheduler event for slow subscriber", "event_type", evt.Type)
+		}
+	}
+
+	eventsTotal.WithLabelValues(string(evt.Type)).Inc()
+}
+
+// Events returns a channel of every Event the scheduler emits from here on,
+// plus a func to unsubscribe. Used by AdminServer's /events SSE endpoint so
+// operators can `curl -N` to watch the agent's activity live instead of
+// tailing logs.
+func (s *Scheduler) Events() (<-chan Event, func()) {
+	return s.events.subscribe()
+}
+
+// emit publishes evt, filling in Time if unset.
+func (s *Scheduler) emit(evt Event) {
+	if evt.Time.IsZero() {
+		evt.Time = time.Now().UTC()
+	}
+	s.events.publish(evt)
+}
+
+// newCorrelationID generates a new ID to tie together the events produced
+// by a single routine run.
+func newCorrelationID() string {
+	return ulid.MustNew(ulid.Timestamp(time.Now()), cryptorand.Reader).String()
+}
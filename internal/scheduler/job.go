@@ -0,0 +1,113 @@
+package scheduler
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+// Job types accepted by Job.JobType.
+const (
+	JobTypePost   = "post"
+	JobTypeFollow = "follow"
+	JobTypeLike   = "like"
+)
+
+// Job is a persisted, independently schedulable unit of work: a posting,
+// following, or liking routine running on its own cron spec against its own
+// Target (a subreddit, an account handle, or "" to use the routine's
+// default behavior).
+type Job struct {
+	ID       string    `json:"id"`
+	CronSpec string    `json:"cron_spec"`
+	JobType  string    `json:"job_type"`
+	Target   string    `json:"target"`
+	Enabled  bool      `json:"enabled"`
+	LastRun  time.Time `json:"last_run"`
+
+	// NextRun is populated by Scheduler.ListJobs from the live cron.Cron
+	// entry for enabled jobs; it is never persisted by JobStore, since it's
+	// only meaningful while the scheduler holding the registration is
+	// running.
+	NextRun time.Time `json:"next_run,omitempty"`
+}
+
+const jobsBucket = "jobs"
+
+// JobStore is a bbolt-backed persisted table of scheduled Jobs, so custom
+// schedules survive a restart instead of being recomputed from fixed config
+// values on every startup.
+type JobStore struct {
+	db *bolt.DB
+}
+
+// OpenJobStore opens (creating if necessary) a job store at path.
+func OpenJobStore(path string) (*JobStore, error) {
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open job store at %s: %w", path, err)
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists([]byte(jobsBucket))
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to initialize job store bucket: %w", err)
+	}
+
+	return &JobStore{db: db}, nil
+}
+
+// Close releases the underlying job store file.
+func (js *JobStore) Close() error {
+	return js.db.Close()
+}
+
+// Add persists a new job, keyed by job.ID.
+func (js *JobStore) Add(job Job) error {
+	return js.put(job)
+}
+
+// Update persists changes to an existing job.
+func (js *JobStore) Update(job Job) error {
+	return js.put(job)
+}
+
+// Remove deletes a job by ID.
+func (js *JobStore) Remove(id string) error {
+	return js.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(jobsBucket)).Delete([]byte(id))
+	})
+}
+
+// List returns every persisted job, enabled or not.
+func (js *JobStore) List() ([]Job, error) {
+	var jobs []Job
+	err := js.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(jobsBucket)).ForEach(func(_, v []byte) error {
+			var job Job
+			if err := json.Unmarshal(v, &job); err != nil {
+				return err
+			}
+			jobs = append(jobs, job)
+			return nil
+		})
+	})
+	return jobs, err
+}
+
+func (js *JobStore) put(job Job) error {
+	job.NextRun = time.Time{}
+	value, err := json.Marshal(job)
+	if err != nil {
+		return fmt.Errorf("failed to marshal job: %w", err)
+	}
+
+	return js.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(jobsBucket)).Put([]byte(job.ID), value)
+	})
+}
@@ -0,0 +1,20 @@
+package scheduler
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// eventsTotal counts every Event the scheduler has emitted, labeled by
+// EventType, so the /metrics endpoint can expose per-event-type counters
+// without the admin server having to know about routines directly.
+var eventsTotal = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "social_agent_scheduler_events_total",
+		Help: "Total number of scheduler events emitted, by event type.",
+	},
+	[]string{"type"},
+)
+
+func init() {
+	prometheus.MustRegister(eventsTotal)
+}
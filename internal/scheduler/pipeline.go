@@ -0,0 +1,199 @@
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"social-agent/internal/agent"
+	"social-agent/internal/logging"
+	"social-agent/internal/pubsub"
+)
+
+// generatedMessage is the pubsub.TopicContentGenerated payload: the
+// generated content plus enough of its source post for the publisher
+// worker to dedupe and ledger-record against.
+type generatedMessage struct {
+	SourceID   string            `json:"source_id"`
+	SourcePost *agent.SourcePost `json:"source_post"`
+	Content    string            `json:"content"`
+}
+
+// publishDiscovered publishes post to pubsub.TopicContentDiscovered for the
+// generator worker to pick up.
+func (s *Scheduler) publishDiscovered(ctx context.Context, post *agent.SourcePost) error {
+	payload, err := json.Marshal(post)
+	if err != nil {
+		return fmt.Errorf("failed to marshal source post: %w", err)
+	}
+	return s.bus.Publish(ctx, pubsub.TopicContentDiscovered, payload)
+}
+
+// startWorkers subscribes the generator and publisher workers to their
+// respective topics and runs them for the lifetime of ctx.
+func (s *Scheduler) startWorkers(ctx context.Context) error {
+	discovered, err := s.bus.Subscribe(ctx, pubsub.TopicContentDiscovered)
+	if err != nil {
+		return fmt.Errorf("failed to subscribe to %s: %w", pubsub.TopicContentDiscovered, err)
+	}
+	go s.generatorWorker(ctx, discovered)
+
+	generated, err := s.bus.Subscribe(ctx, pubsub.TopicContentGenerated)
+	if err != nil {
+		return fmt.Errorf("failed to subscribe to %s: %w", pubsub.TopicContentGenerated, err)
+	}
+	go s.publisherWorker(ctx, generated)
+
+	return nil
+}
+
+// generatorWorker consumes discovered posts, calls the content generator,
+// and publishes the result for the publisher worker. A generation failure
+// is nacked so pubsub.Bus retries it (and eventually dead-letters it)
+// rather than silently dropping the source post.
+func (s *Scheduler) generatorWorker(ctx context.Context, deliveries <-chan pubsub.Delivery) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case delivery, ok := <-deliveries:
+			if !ok {
+				return
+			}
+			s.handleDiscovered(ctx, delivery)
+		}
+	}
+}
+
+func (s *Scheduler) handleDiscovered(ctx context.Context, delivery pubsub.Delivery) {
+	logger := logging.WithRoutine(s.logger, "scheduler", "generator_worker")
+
+	var post agent.SourcePost
+	if err := json.Unmarshal(delivery.Message.Payload, &post); err != nil {
+		logger.Error("failed to unmarshal discovered post", "error", err)
+		delivery.Nack()
+		return
+	}
+
+	generatedPost, err := s.postGen.Generate(ctx, &post)
+	if err != nil {
+		logger.Error("failed to generate post", "source_id", post.ID, "error", err)
+		delivery.Nack()
+		return
+	}
+
+	s.emit(Event{
+		Type:          EventPostGenerated,
+		CorrelationID: post.ID,
+		Message:       "generated post content",
+		Data:          map[string]any{"source_id": post.ID},
+	})
+
+	if s.ledger != nil {
+		seen, err := s.ledger.SeenContent(ledgerDestination, generatedPost.Content)
+		if err != nil {
+			logger.Error("failed to check ledger for seen content", "error", err)
+		} else if seen {
+			logger.Info("generated content already posted, skipping", "source_id", post.ID)
+			delivery.Ack()
+			return
+		}
+	}
+
+	payload, err := json.Marshal(generatedMessage{
+		SourceID:   post.ID,
+		SourcePost: &post,
+		Content:    generatedPost.Content,
+	})
+	if err != nil {
+		logger.Error("failed to marshal generated message", "source_id", post.ID, "error", err)
+		delivery.Nack()
+		return
+	}
+
+	if err := s.bus.Publish(ctx, pubsub.TopicContentGenerated, payload); err != nil {
+		logger.Error("failed to publish generated content", "source_id", post.ID, "error", err)
+		delivery.Nack()
+		return
+	}
+
+	delivery.Ack()
+}
+
+// publisherWorker consumes generated content, fans it out to every
+// configured poster, and records the outcome in the ledger and seen store.
+// A posting failure is nacked, not dropped, so the retry/DLQ policy of
+// pubsub.Bus governs how many times a generated post is retried before an
+// operator has to intervene.
+func (s *Scheduler) publisherWorker(ctx context.Context, deliveries <-chan pubsub.Delivery) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case delivery, ok := <-deliveries:
+			if !ok {
+				return
+			}
+			s.handleGenerated(ctx, delivery)
+		}
+	}
+}
+
+func (s *Scheduler) handleGenerated(ctx context.Context, delivery pubsub.Delivery) {
+	logger := logging.WithRoutine(s.logger, "scheduler", "publisher_worker")
+
+	var msg generatedMessage
+	if err := json.Unmarshal(delivery.Message.Payload, &msg); err != nil {
+		logger.Error("failed to unmarshal generated message", "error", err)
+		delivery.Nack()
+		return
+	}
+
+	postID, posted := s.postToAll(ctx, logger, msg.Content)
+	if !posted {
+		logger.Error("failed to post to any configured network", "source_id", msg.SourceID)
+		s.emit(Event{
+			Type:          EventPostFailed,
+			CorrelationID: msg.SourceID,
+			Message:       "failed to post to any configured network",
+			Data:          map[string]any{"source_id": msg.SourceID},
+		})
+		if err := s.publishPostFailed(ctx, msg); err != nil {
+			logger.Error("failed to publish post.failed", "source_id", msg.SourceID, "error", err)
+		}
+		delivery.Nack()
+		return
+	}
+
+	logger.Info("successfully posted to social media", "post_id", postID, "source_id", msg.SourceID)
+	s.emit(Event{
+		Type:          EventPostPublished,
+		CorrelationID: msg.SourceID,
+		Message:       "posted to social media",
+		Data:          map[string]any{"source_id": msg.SourceID, "post_id": postID},
+	})
+
+	if s.ledger != nil {
+		if _, err := s.ledger.Record(msg.SourceID, msg.Content, ledgerDestination, postID); err != nil {
+			logger.Error("failed to record post in ledger", "error", err)
+		}
+	}
+
+	if s.seenStore != nil {
+		s.seenStore.MarkPosted(msg.SourceID, postID)
+	}
+
+	if err := s.bus.Publish(ctx, pubsub.TopicPostCreated, delivery.Message.Payload); err != nil {
+		logger.Error("failed to publish post.created", "source_id", msg.SourceID, "error", err)
+	}
+
+	delivery.Ack()
+}
+
+func (s *Scheduler) publishPostFailed(ctx context.Context, msg generatedMessage) error {
+	payload, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("failed to marshal failed post message: %w", err)
+	}
+	return s.bus.Publish(ctx, pubsub.TopicPostFailed, payload)
+}
@@ -2,87 +2,166 @@ package scheduler
 
 import (
 	"context"
+	cryptorand "crypto/rand"
 	"fmt"
-	"github.com/robfig/cron/v3"
 	"log/slog"
 	"math/rand"
-	"social-agent/config"
-	"social-agent/internal/content"
-	"social-agent/internal/social/bluesky"
-	"social-agent/internal/social/twitter"
+	"sync"
 	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/robfig/cron/v3"
+
+	"social-agent/config"
+	"social-agent/internal/agent"
+	"social-agent/internal/content/source"
+	"social-agent/internal/ledger"
+	"social-agent/internal/logging"
+	"social-agent/internal/pubsub"
+	"social-agent/internal/social"
+	"social-agent/internal/social/engagement"
+	"social-agent/internal/social/reddit"
+	"social-agent/internal/store"
+	"social-agent/internal/timeline"
+	"social-agent/internal/watcher"
 )
 
-// Scheduler manages posting, following, and engagement activities.
+// engagementSource is the Bluesky capability followRoutine/likeRoutine need
+// for engagement.RunFollow/RunLike (candidate discovery) plus the ordinary
+// Poster actions (follow/like) that carry them out. The Bluesky client
+// satisfies both.
+type engagementSource interface {
+	engagement.Source
+	social.Poster
+}
+
+// Scheduler manages posting, following, and engagement activities. Rather
+// than a fixed schedule computed at startup, it runs whatever jobs are
+// persisted in its JobStore, and jobs can be added, updated, or removed at
+// runtime via AddJob/UpdateJob/RemoveJob without restarting the process.
 type Scheduler struct {
-	cron          *cron.Cron
-	contentSource twitter.ContentSource
-	socialMedia   bluesky.ContentDestination
-	postGen       *content.Agent
-	config        *config.Config
+	cron         *cron.Cron
+	sources      *source.Registry
+	redditSource reddit.ContentSource
+	posters      []social.Poster
+	postGen      *agent.Agent
+	ledger       *ledger.Ledger
+	seenStore    store.SeenStore
+	jobStore     *JobStore
+	watchers     watcher.Repository
+	bus          pubsub.Bus
+	timeline     *timeline.Timeline
+	config       *config.Config
+	events       *eventRelay
+	logger       *slog.Logger
+
+	bluesky            engagementSource
+	engagementCooldown store.SeenStore
+	followSeeds        []string
+
+	mu      sync.Mutex
+	entries map[cron.EntryID]Job
 }
 
-// New creates a new scheduler.
+// New creates a new scheduler. sources is the pool of content.source
+// plugins postRoutine draws candidates from (Twitter, Reddit, ...); it may
+// be nil or empty, in which case postRoutine has no candidates and does
+// nothing. redditSource is used separately from sources, purely to
+// evaluate watcher follow/like targeting rules against raw Reddit posts; it
+// may be nil, in which case followRoutine and likeRoutine have no
+// watcher-matched posts to act on. ledger may be nil, in which case the post
+// routine does not dedupe against prior runs. seenStore may be nil, in
+// which case a published post's URI isn't recorded against its source ID
+// for audit/rollback. jobStore may be nil, in which case no jobs are
+// loaded at startup and AddJob/UpdateJob/RemoveJob/ListJobs return an
+// error. watchers may be nil, in which case followRoutine and likeRoutine
+// have no watcher-matched posts to act on. bus may be nil, in which case
+// postRoutine generates and posts inline rather than publishing a
+// pubsub.TopicContentDiscovered message for the generator/publisher workers
+// to pick up; with a non-nil bus, Start subscribes those workers to run the
+// decoupled pipeline. A generated post is fanned out to every entry in posters
+// (Bluesky, Mastodon, ...). tl may be nil, in which case postRoutine neither
+// records timeline entries nor rejects near-duplicate content by recent
+// content-hash collision, relying solely on ledger for dedupe. With a bus,
+// postRoutine only records seen candidates in tl; the generator and
+// publisher workers neither check tl for collisions nor record generated or
+// posted content in it. bluesky may
+// be nil, in which case followRoutine and likeRoutine skip Bluesky
+// discovery entirely and fall back to watcher-matched Reddit targeting
+// only. engagementCooldown may be nil, in which case engagement candidates
+// are never excluded as recently-followed/liked. followSeeds is a small
+// configured list of accounts whose follows are worth expanding into (we
+// have no API to enumerate our own following list, so this stands in for
+// "accounts we already follow" in engagement.DiscoverFollowCandidates).
 func New(
-	contentSource twitter.ContentSource,
-	socialMedia bluesky.ContentDestination,
-	postGen *content.Agent,
+	sources *source.Registry,
+	redditSource reddit.ContentSource,
+	posters []social.Poster,
+	postGen *agent.Agent,
+	ledger *ledger.Ledger,
+	seenStore store.SeenStore,
+	jobStore *JobStore,
+	watchers watcher.Repository,
+	bus pubsub.Bus,
+	tl *timeline.Timeline,
 	config *config.Config,
+	bluesky engagementSource,
+	engagementCooldown store.SeenStore,
+	followSeeds []string,
 ) *Scheduler {
 	return &Scheduler{
-		cron:          cron.New(),
-		contentSource: contentSource,
-		socialMedia:   socialMedia,
-		postGen:       postGen,
-		config:        config,
+		cron:               cron.New(),
+		sources:            sources,
+		redditSource:       redditSource,
+		posters:            posters,
+		postGen:            postGen,
+		ledger:             ledger,
+		seenStore:          seenStore,
+		jobStore:           jobStore,
+		watchers:           watchers,
+		bus:                bus,
+		timeline:           tl,
+		config:             config,
+		events:             newEventRelay(),
+		logger:             logging.WithModule(slog.Default(), "scheduler"),
+		bluesky:            bluesky,
+		engagementCooldown: engagementCooldown,
+		followSeeds:        followSeeds,
+		entries:            make(map[cron.EntryID]Job),
 	}
 }
 
-// Start initializes and starts the scheduler.
+// Start loads every enabled job from the job store and registers it with
+// the underlying cron.Cron, then starts the cron runner. This replaces the
+// previous fixed PostingScheduleHour/follow/like hours computed once at
+// startup (see the now-obsolete POSTING_SCHEDULE_HOUR_* config) with
+// per-target schedules that can change without a restart.
 func (s *Scheduler) Start(ctx context.Context) error {
-	minute := rand.Intn(60)
-	cronSpec := fmt.Sprintf("%d %d * * *", minute, s.config.PostingScheduleHour)
-
-	_, err := s.cron.AddFunc(cronSpec, func() {
-		s.postRoutine(context.Background())
-	})
-	if err != nil {
-		slog.Error("failed to schedule post at", "hour", s.config.PostingScheduleHour, "minute", minute, "error", err)
-		return err
-	}
-
-	slog.Info("scheduled post creation at", "hour", s.config.PostingScheduleHour, "minute", minute)
-
-	followHour := 9 + rand.Intn(10)
-	followMin := rand.Intn(60)
-	followCron := fmt.Sprintf("%d %d * * *", followMin, followHour)
-
-	_, err = s.cron.AddFunc(followCron, func() {
-		s.followRoutine(context.Background())
-	})
-	if err != nil {
-		slog.Error("failed to schedule follow routine", "error", err)
-		return err
+	if s.jobStore == nil {
+		s.logger.Warn("scheduler started without a job store; no jobs will run until AddJob is called")
+	} else {
+		jobs, err := s.jobStore.List()
+		if err != nil {
+			return fmt.Errorf("failed to load jobs from store: %w", err)
+		}
+		for _, job := range jobs {
+			if !job.Enabled {
+				continue
+			}
+			if _, err := s.registerJob(job); err != nil {
+				s.logger.Error("failed to register job", "job_id", job.ID, "error", err)
+			}
+		}
 	}
 
-	slog.Info("scheduled follow routine", "hour", followHour, "minute", followMin)
-
-	likeHour := 10 + rand.Intn(9)
-	likeMin := rand.Intn(60)
-	likeCron := fmt.Sprintf("%d %d * * *", likeMin, likeHour)
-
-	_, err = s.cron.AddFunc(likeCron, func() {
-		s.likeRoutine(context.Background())
-	})
-	if err != nil {
-		slog.Error("failed to schedule like routine", "error", err)
-		return err
+	if s.bus != nil {
+		if err := s.startWorkers(ctx); err != nil {
+			return fmt.Errorf("failed to start pipeline workers: %w", err)
+		}
 	}
 
-	slog.Info("scheduled like routine", "hour", likeHour, "minute", likeMin)
-
 	s.cron.Start()
-	slog.Info("scheduler started")
+	s.logger.Info("scheduler started", "job_count", len(s.entries))
 
 	return nil
 }
@@ -90,7 +169,7 @@ func (s *Scheduler) Start(ctx context.Context) error {
 // Stop gracefully stops the scheduler.
 func (s *Scheduler) Stop() {
 	s.cron.Stop()
-	slog.Info("scheduler stopped")
+	s.logger.Info("scheduler stopped")
 }
 
 // RunPostRoutine exposes postRoutine for testing and direct invocation
@@ -108,70 +187,548 @@ func (s *Scheduler) RunLikeRoutine(ctx context.Context) {
 	s.likeRoutine(ctx)
 }
 
-func (s *Scheduler) postRoutine(ctx context.Context) {
-	slog.Info("starting post creation routine")
+// AddJob persists a new job (assigning job.ID if empty) and, if enabled,
+// atomically registers it with the underlying cron.Cron.
+func (s *Scheduler) AddJob(job Job) (Job, error) {
+	if s.jobStore == nil {
+		return Job{}, fmt.Errorf("scheduler has no job store configured")
+	}
+
+	if job.ID == "" {
+		job.ID = ulid.MustNew(ulid.Timestamp(time.Now()), cryptorand.Reader).String()
+	}
+
+	if err := s.jobStore.Add(job); err != nil {
+		return Job{}, fmt.Errorf("failed to persist job: %w", err)
+	}
+
+	if job.Enabled {
+		if _, err := s.registerJob(job); err != nil {
+			return Job{}, err
+		}
+	}
+
+	return job, nil
+}
+
+// UpdateJob persists changes to an existing job, unregistering its previous
+// cron.EntryID (if any) and re-registering it if still enabled.
+func (s *Scheduler) UpdateJob(job Job) error {
+	if s.jobStore == nil {
+		return fmt.Errorf("scheduler has no job store configured")
+	}
+
+	s.unregisterJob(job.ID)
+
+	if err := s.jobStore.Update(job); err != nil {
+		return fmt.Errorf("failed to persist job update: %w", err)
+	}
+
+	if job.Enabled {
+		if _, err := s.registerJob(job); err != nil {
+			return err
+		}
+	}
 
-	posts, err := s.contentSource.QueryWorkRantTweets(3)
+	return nil
+}
+
+// RemoveJob unregisters id's cron entry (if any) and deletes it from the
+// job store.
+func (s *Scheduler) RemoveJob(id string) error {
+	if s.jobStore == nil {
+		return fmt.Errorf("scheduler has no job store configured")
+	}
+
+	s.unregisterJob(id)
+
+	if err := s.jobStore.Remove(id); err != nil {
+		return fmt.Errorf("failed to remove job: %w", err)
+	}
+
+	return nil
+}
+
+// ListJobs returns every persisted job, enabled or not, with NextRun filled
+// in from the live cron registration of whichever jobs are currently
+// enabled.
+func (s *Scheduler) ListJobs() ([]Job, error) {
+	if s.jobStore == nil {
+		return nil, fmt.Errorf("scheduler has no job store configured")
+	}
+
+	jobs, err := s.jobStore.List()
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	nextRun := make(map[string]time.Time, len(s.entries))
+	for entryID, job := range s.entries {
+		nextRun[job.ID] = s.cron.Entry(entryID).Next
+	}
+	s.mu.Unlock()
+
+	for i := range jobs {
+		jobs[i].NextRun = nextRun[jobs[i].ID]
+	}
+
+	return jobs, nil
+}
+
+// registerJob wires job's cron spec to its job-type routine and records the
+// resulting cron.EntryID so it can be looked up again by UpdateJob/RemoveJob.
+func (s *Scheduler) registerJob(job Job) (cron.EntryID, error) {
+	fn, err := s.jobFunc(job)
 	if err != nil {
-		slog.Error("failed to query Twitter/X", "error", err)
+		return 0, err
+	}
+
+	entryID, err := s.cron.AddFunc(job.CronSpec, fn)
+	if err != nil {
+		return 0, fmt.Errorf("invalid cron spec %q for job %s: %w", job.CronSpec, job.ID, err)
+	}
+
+	s.mu.Lock()
+	s.entries[entryID] = job
+	s.mu.Unlock()
+
+	return entryID, nil
+}
+
+// unregisterJob removes id's cron entry, if one is currently registered.
+func (s *Scheduler) unregisterJob(id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for entryID, job := range s.entries {
+		if job.ID == id {
+			s.cron.Remove(entryID)
+			delete(s.entries, entryID)
+			return
+		}
+	}
+}
+
+// jobFunc dispatches job to the routine matching its JobType.
+func (s *Scheduler) jobFunc(job Job) (func(), error) {
+	switch job.JobType {
+	case JobTypePost:
+		return func() { s.runJob(job, s.postRoutine) }, nil
+	case JobTypeFollow:
+		return func() { s.runJob(job, s.followRoutine) }, nil
+	case JobTypeLike:
+		return func() { s.runJob(job, s.likeRoutine) }, nil
+	default:
+		return nil, fmt.Errorf("unknown job type %q", job.JobType)
+	}
+}
+
+// runJob invokes routine and records job's LastRun in the job store.
+// Target-scoped routing (e.g. restricting post generation to a single
+// subreddit) is coarse today: routine still draws from every configured
+// content source, with Target only logged for visibility, pending deeper
+// per-source wiring.
+func (s *Scheduler) runJob(job Job, routine func(context.Context)) {
+	s.logger.Info("running scheduled job", "job_id", job.ID, "job_type", job.JobType, "target", job.Target)
+	routine(context.Background())
+
+	job.LastRun = time.Now().UTC()
+	if err := s.jobStore.Update(job); err != nil {
+		s.logger.Error("failed to record job run", "job_id", job.ID, "error", err)
+	}
+}
+
+func (s *Scheduler) postRoutine(ctx context.Context) {
+	logger := logging.WithRoutine(s.logger, "scheduler", "post_creation")
+	logger.Info("starting post creation routine")
+	correlationID := newCorrelationID()
+	s.emit(Event{Type: EventRoutineStarted, CorrelationID: correlationID, Message: "post routine started"})
+
+	if s.sources == nil {
+		logger.Error("no content sources configured")
 		return
 	}
 
-	if len(posts) == 0 {
-		slog.Error("no work rant posts found on Twitter/X")
+	candidates := s.sources.FetchAll(ctx, source.Query{Limit: 3, MaxContentAgeDays: s.config.MaxContentAgeDays})
+	if len(candidates) == 0 {
+		logger.Error("no recent candidate posts found across configured sources")
 		return
 	}
 
-	cutoffTime := time.Now().AddDate(0, 0, -s.config.MaxContentAgeDays)
-	var recentPosts []*twitter.Post
-	for i, post := range posts {
-		if post.CreatedAt.After(cutoffTime) {
-			recentPosts = append(recentPosts, &posts[i])
+	if s.ledger != nil {
+		candidates = s.filterSeenSources(logger, candidates)
+		if len(candidates) == 0 {
+			logger.Info("all candidate posts already processed, skipping run")
+			return
+		}
+	}
+
+	if s.timeline != nil {
+		for _, candidate := range candidates {
+			if err := s.timeline.RecordSeen(candidate.ID, candidate.URL); err != nil {
+				logger.Error("failed to record seen candidate in timeline", "source_id", candidate.ID, "error", err)
+			}
 		}
 	}
 
-	if len(recentPosts) == 0 {
-		slog.Error("no recent work rant posts found on Twitter/X")
+	selectedPost := candidates[rand.Intn(len(candidates))]
+	logger.Debug("selected post for generation", "source", selectedPost.Source, "content", selectedPost.Content)
+
+	if s.bus == nil {
+		s.generateAndPost(ctx, logger, correlationID, selectedPost)
 		return
 	}
 
-	selectedPost := recentPosts[rand.Intn(len(recentPosts))]
-	slog.Debug("selected post for generation", "content", selectedPost.Content)
+	if err := s.publishDiscovered(ctx, selectedPost); err != nil {
+		logger.Error("failed to publish discovered post", "source_id", selectedPost.ID, "error", err)
+	}
+}
 
+// generateAndPost runs the generate-then-post chain inline, without a bus.
+// It is the fallback postRoutine takes when the scheduler has no pubsub.Bus
+// configured, preserving the original synchronous behavior.
+func (s *Scheduler) generateAndPost(ctx context.Context, logger *slog.Logger, correlationID string, selectedPost *agent.SourcePost) {
 	generatedPost, err := s.postGen.Generate(ctx, selectedPost)
 	if err != nil {
-		slog.Error("failed to generate post", "error", err)
+		logger.Error("failed to generate post", "error", err)
 		return
 	}
 
-	postID, err := s.socialMedia.CreatePost(generatedPost.Content)
-	if err != nil {
-		slog.Error("failed to post to social media", "error", err)
+	s.emit(Event{
+		Type:          EventPostGenerated,
+		CorrelationID: correlationID,
+		Message:       "generated post content",
+		Data:          map[string]any{"source_id": selectedPost.ID},
+	})
+
+	if s.ledger != nil {
+		seen, err := s.ledger.SeenContent(ledgerDestination, generatedPost.Content)
+		if err != nil {
+			logger.Error("failed to check ledger for seen content", "error", err)
+		} else if seen {
+			logger.Info("generated content already posted, skipping", "source_id", selectedPost.ID)
+			return
+		}
+	}
+
+	if s.timeline != nil {
+		window := time.Duration(s.config.TimelineCollisionWindowDays) * 24 * time.Hour
+		if window > 0 {
+			collided, err := s.timeline.RecentCollision(generatedPost.Content, window)
+			if err != nil {
+				logger.Error("failed to check timeline for recent content collision", "error", err)
+			} else if collided {
+				logger.Info("generated content collides with a recent post, skipping", "source_id", selectedPost.ID)
+				return
+			}
+		}
+		if _, err := s.timeline.RecordGenerated(selectedPost.ID, generatedPost.Content); err != nil {
+			logger.Error("failed to record generated content in timeline", "source_id", selectedPost.ID, "error", err)
+		}
+	}
+
+	postID, posted := s.postToAll(ctx, logger, generatedPost.Content)
+	if !posted {
+		logger.Error("failed to post to any configured network")
+		s.emit(Event{
+			Type:          EventPostFailed,
+			CorrelationID: correlationID,
+			Message:       "failed to post to any configured network",
+			Data:          map[string]any{"source_id": selectedPost.ID},
+		})
 		return
 	}
 
-	slog.Info("successfully posted to social media", "post_id", postID)
+	logger.Info("successfully posted to social media", "post_id", postID)
+	s.emit(Event{
+		Type:          EventPostPublished,
+		CorrelationID: correlationID,
+		Message:       "posted to social media",
+		Data:          map[string]any{"source_id": selectedPost.ID, "post_id": postID},
+	})
+
+	if s.ledger != nil {
+		if _, err := s.ledger.Record(selectedPost.ID, generatedPost.Content, ledgerDestination, postID); err != nil {
+			logger.Error("failed to record post in ledger", "error", err)
+		}
+	}
+
+	if s.seenStore != nil {
+		s.seenStore.MarkPosted(selectedPost.ID, postID)
+	}
+
+	if s.timeline != nil {
+		if _, err := s.timeline.RecordPosted(selectedPost.ID, ledgerDestination, postID, generatedPost.Content); err != nil {
+			logger.Error("failed to record posted content in timeline", "source_id", selectedPost.ID, "error", err)
+		}
+	}
+}
+
+// ledgerDestination is the ledger bucket key shared by every network a post
+// is fanned out to, since the ledger dedupes by generated content rather
+// than by destination.
+const ledgerDestination = "social"
+
+// postToAll fans content out to every configured poster, logging and
+// continuing past individual failures. It reports the first successful
+// post ID (for the ledger) and whether at least one poster succeeded.
+func (s *Scheduler) postToAll(ctx context.Context, logger *slog.Logger, content string) (string, bool) {
+	var firstID string
+	posted := false
+	for _, poster := range s.posters {
+		postID, err := poster.CreatePost(ctx, content)
+		if err != nil {
+			logger.Error("failed to post to social network", "error", err)
+			continue
+		}
+		posted = true
+		if firstID == "" {
+			firstID = postID
+		}
+	}
+	return firstID, posted
+}
+
+// filterSeenSources drops every candidate whose source ID has already been
+// recorded in the ledger, so the agent doesn't waste a Gemini call
+// regenerating content it has already processed.
+func (s *Scheduler) filterSeenSources(logger *slog.Logger, candidates []*agent.SourcePost) []*agent.SourcePost {
+	var fresh []*agent.SourcePost
+	for _, candidate := range candidates {
+		seen, err := s.ledger.SeenSource(candidate.ID)
+		if err != nil {
+			logger.Error("failed to check ledger for seen source", "source_id", candidate.ID, "error", err)
+			continue
+		}
+		if !seen {
+			fresh = append(fresh, candidate)
+		}
+	}
+	return fresh
 }
 
+// matchingRedditPosts fetches recent Reddit posts and returns, for each one
+// that satisfies at least one enabled watcher, the post paired with the
+// first enabled watcher it matched; later watchers are not evaluated for
+// that post. Nil s.redditSource or s.watchers yields no matches.
+func (s *Scheduler) matchingRedditPosts(ctx context.Context, logger *slog.Logger) ([]*reddit.Post, []watcher.Watcher) {
+	if s.redditSource == nil || s.watchers == nil {
+		return nil, nil
+	}
+
+	watchers, err := s.watchers.List()
+	if err != nil {
+		logger.Error("failed to list watchers", "error", err)
+		return nil, nil
+	}
+
+	posts, err := s.redditSource.FetchRecent(ctx, s.config.MaxContentAgeDays)
+	if err != nil {
+		logger.Error("failed to query Reddit for watcher evaluation", "error", err)
+		return nil, nil
+	}
+
+	var matchedPosts []*reddit.Post
+	var matchedBy []watcher.Watcher
+	for _, post := range posts {
+		for _, w := range watchers {
+			if w.Enabled && w.Matches(post) {
+				matchedPosts = append(matchedPosts, post)
+				matchedBy = append(matchedBy, w)
+				break
+			}
+		}
+	}
+
+	return matchedPosts, matchedBy
+}
+
+// followRoutine follows the author of every Reddit post whose first
+// matching enabled Watcher has Action follow or both (see
+// matchingRedditPosts); a post whose first match is a like-only watcher is
+// not followed, even if a later watcher has Action follow.
 func (s *Scheduler) followRoutine(ctx context.Context) {
-	// TODO: This should be implmeented
-	return
+	logger := logging.WithRoutine(s.logger, "scheduler", "follow")
+	logger.Info("starting follow routine")
+	correlationID := newCorrelationID()
+	s.emit(Event{Type: EventRoutineStarted, CorrelationID: correlationID, Message: "follow routine started"})
+
+	posts, watchers := s.matchingRedditPosts(ctx, logger)
+	for i, post := range posts {
+		if !watchers[i].WantsFollow() {
+			continue
+		}
+		for _, poster := range s.posters {
+			if err := poster.FollowUser(ctx, post.Author); err != nil {
+				logger.Error("failed to follow user", "author", post.Author, "watcher", watchers[i].Label, "error", err)
+				s.emit(Event{
+					Type:          EventFollowFailed,
+					CorrelationID: correlationID,
+					Message:       fmt.Sprintf("failed to follow %s", post.Author),
+					Data:          map[string]any{"author": post.Author, "watcher": watchers[i].Label, "error": err.Error()},
+				})
+				continue
+			}
+			logger.Info("followed user matched by watcher", "author", post.Author, "watcher", watchers[i].Label)
+			s.emit(Event{
+				Type:          EventFollowSucceeded,
+				CorrelationID: correlationID,
+				Message:       fmt.Sprintf("followed %s", post.Author),
+				Data:          map[string]any{"author": post.Author, "watcher": watchers[i].Label},
+			})
+		}
+	}
+
+	s.runEngagementFollow(ctx, logger, correlationID)
 }
 
+// runEngagementFollow discovers and follows Bluesky accounts via
+// internal/social/engagement, on top of the watcher-matched Reddit follows
+// above. A no-op if s.bluesky is nil.
+func (s *Scheduler) runEngagementFollow(ctx context.Context, logger *slog.Logger, correlationID string) {
+	if s.bluesky == nil {
+		return
+	}
+
+	var recentPostURIs []string
+	if uris, err := s.bluesky.GetRecentPosts(ctx, 10); err != nil {
+		logger.Error("failed to fetch recent Bluesky posts for follow discovery", "error", err)
+	} else {
+		recentPostURIs = uris
+	}
+
+	report := engagement.RunFollow(ctx, s.bluesky, s.bluesky, s.engagementCooldown, s.config.PostContentTheme, recentPostURIs, s.followSeeds, s.followConfig(), logger)
+	logger.Info("engagement follow routine finished", "discovered", report.Discovered, "followed", len(report.Followed), "skipped", len(report.Skipped), "failed", len(report.Failed))
+
+	for _, handle := range report.Followed {
+		s.emit(Event{
+			Type:          EventFollowSucceeded,
+			CorrelationID: correlationID,
+			Message:       fmt.Sprintf("followed %s", handle),
+			Data:          map[string]any{"author": handle, "source": "engagement"},
+		})
+	}
+	for handle, reason := range report.Failed {
+		s.emit(Event{
+			Type:          EventFollowFailed,
+			CorrelationID: correlationID,
+			Message:       fmt.Sprintf("failed to follow %s", handle),
+			Data:          map[string]any{"author": handle, "source": "engagement", "error": reason},
+		})
+	}
+}
+
+// followConfig builds engagement.FollowConfig from s.config.
+func (s *Scheduler) followConfig() engagement.FollowConfig {
+	return engagement.FollowConfig{
+		PerDay:      s.config.FollowPerDay,
+		Jitter:      time.Duration(s.config.EngagementJitterSeconds) * time.Second,
+		Cooldown:    time.Duration(s.config.EngagementCooldownHours) * time.Hour,
+		SearchLimit: 25,
+		GraphLimit:  25,
+	}
+}
+
+// likeRoutine likes the published post corresponding to every Reddit post
+// whose first matching enabled Watcher has Action like or both (see
+// matchingRedditPosts). A Reddit post only
+// has a corresponding published post once postRoutine has generated and
+// dispatched content from it, recorded in the ledger under the same source
+// ID, so matches without a ledger entry yet are silently skipped.
 func (s *Scheduler) likeRoutine(ctx context.Context) {
-	slog.Info("starting like routine")
+	logger := logging.WithRoutine(s.logger, "scheduler", "like")
+	logger.Info("starting like routine")
+	correlationID := newCorrelationID()
+	s.emit(Event{Type: EventRoutineStarted, CorrelationID: correlationID, Message: "like routine started"})
 
-	likeCount := s.config.LikePostsPerDay
-	if likeCount <= 0 {
-		slog.Info("like routine skipped (LikePostsPerDay is 0)")
+	if s.ledger == nil {
+		logger.Info("like routine skipped (no ledger configured to resolve source posts to published URIs)")
 		return
 	}
 
-	err := s.socialMedia.LikeRecentPosts(likeCount)
-	if err != nil {
-		slog.Error("failed to like recent posts", "error", err)
+	liked := 0
+	posts, watchers := s.matchingRedditPosts(ctx, logger)
+	for i, post := range posts {
+		if s.config.LikePostsPerDay > 0 && liked >= s.config.LikePostsPerDay {
+			break
+		}
+		if !watchers[i].WantsLike() {
+			continue
+		}
+
+		uri, found, err := s.ledger.URIForSource(post.ID)
+		if err != nil {
+			logger.Error("failed to resolve source post in ledger", "source_id", post.ID, "error", err)
+			continue
+		}
+		if !found {
+			continue
+		}
+
+		for _, poster := range s.posters {
+			if err := poster.LikePost(ctx, uri); err != nil {
+				logger.Error("failed to like post", "uri", uri, "watcher", watchers[i].Label, "error", err)
+				s.emit(Event{
+					Type:          EventLikeFailed,
+					CorrelationID: correlationID,
+					Message:       fmt.Sprintf("failed to like %s", uri),
+					Data:          map[string]any{"uri": uri, "watcher": watchers[i].Label, "error": err.Error()},
+				})
+				continue
+			}
+			logger.Info("liked post matched by watcher", "uri", uri, "watcher", watchers[i].Label)
+			s.emit(Event{
+				Type:          EventLikeSucceeded,
+				CorrelationID: correlationID,
+				Message:       fmt.Sprintf("liked %s", uri),
+				Data:          map[string]any{"uri": uri, "watcher": watchers[i].Label},
+			})
+		}
+		liked++
+	}
+
+	s.runEngagementLike(ctx, logger, correlationID)
+}
+
+// runEngagementLike discovers and likes Bluesky posts matching the
+// configured theme via internal/social/engagement, on top of the
+// watcher-matched Reddit likes above. A no-op if s.bluesky is nil.
+func (s *Scheduler) runEngagementLike(ctx context.Context, logger *slog.Logger, correlationID string) {
+	if s.bluesky == nil {
 		return
 	}
 
-	slog.Info("like routine completed", "count", likeCount)
+	report := engagement.RunLike(ctx, s.bluesky, s.bluesky, s.engagementCooldown, s.config.PostContentTheme, s.likeConfig(), logger)
+	logger.Info("engagement like routine finished", "discovered", report.Discovered, "liked", len(report.Liked), "skipped", len(report.Skipped), "failed", len(report.Failed))
+
+	for _, uri := range report.Liked {
+		s.emit(Event{
+			Type:          EventLikeSucceeded,
+			CorrelationID: correlationID,
+			Message:       fmt.Sprintf("liked %s", uri),
+			Data:          map[string]any{"uri": uri, "source": "engagement"},
+		})
+	}
+	for uri, reason := range report.Failed {
+		s.emit(Event{
+			Type:          EventLikeFailed,
+			CorrelationID: correlationID,
+			Message:       fmt.Sprintf("failed to like %s", uri),
+			Data:          map[string]any{"uri": uri, "source": "engagement", "error": reason},
+		})
+	}
+}
+
+// likeConfig builds engagement.LikeConfig from s.config.
+func (s *Scheduler) likeConfig() engagement.LikeConfig {
+	return engagement.LikeConfig{
+		PerDay:       s.config.LikePostsPerDay,
+		Jitter:       time.Duration(s.config.EngagementJitterSeconds) * time.Second,
+		Cooldown:     time.Duration(s.config.EngagementCooldownHours) * time.Hour,
+		SearchLimit:  25,
+		MaxAge:       time.Duration(s.config.EngagementMaxAgeHours) * time.Hour,
+		AllowedLangs: s.config.EngagementAllowedLangs,
+		BannedTerms:  s.config.EngagementBannedTerms,
+	}
 }
@@ -2,27 +2,67 @@ package bluesky
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
-	"io"
-	"log/slog" 
+	"log/slog"
 	"net/http"
+	"os"
+	"sync"
 	"time"
+
+	"github.com/valyala/fastjson"
+
+	"social-agent/internal/httpx"
+	"social-agent/internal/social"
 )
 
 // ContentDestination defines the interface for content destinations
 type ContentDestination interface {
-	CreatePost(text string) (string, error)
-	FollowUser(userHandle string) error
-	LikePost(postID string) error
-	GetRecentPosts(limit int) ([]string, error)
+	CreatePost(ctx context.Context, text string) (string, error)
+	FollowUser(ctx context.Context, userHandle string) error
+	LikePost(ctx context.Context, postID string) error
+	GetRecentPosts(ctx context.Context, limit int) ([]string, error)
 }
 
+// Ensure blueskyClient also satisfies the shared cross-network contract
+// the scheduler fans posts out through.
+var _ social.Poster = (*blueskyClient)(nil)
+
+// defaultSessionFile is used when BSKY_SESSION_FILE is not set.
+const defaultSessionFile = "bsky_session.json"
+
 type blueskyClient struct {
 	baseURL     string
-	accessToken string
-	did         string
-	httpClient  *http.Client
+	identifier  string
+	appPassword string
+	sessionFile string
+	transport   *httpx.Transport
+	ParsePool   fastjson.ParserPool
+
+	mu            sync.Mutex
+	accessJwt     string
+	refreshJwt    string
+	did           string
+	lastRateLimit httpx.RateLimitingInfo
+}
+
+// Post is a typed view of a timeline entry, populated without a full
+// encoding/json unmarshal of the (potentially large) feed response.
+type Post struct {
+	URI          string
+	CID          string
+	AuthorHandle string
+	Text         string
+	Lang         string
+	CreatedAt    time.Time
+}
+
+// Actor is a typed view of an ATProto actor (a search result, a liker, a
+// followed account, ...), populated the same fastjson-walking way as Post.
+type Actor struct {
+	DID    string
+	Handle string
 }
 
 type createPostRequest struct {
@@ -42,34 +82,257 @@ type postRecord struct {
 	CreatedAt time.Time `json:"createdAt"`
 }
 
-type feedResponse struct {
-	Feed []struct {
-		Post struct {
-			URI    string `json:"uri"`
-			CID    string `json:"cid"`
-			Author struct {
-				Handle string `json:"handle"`
-			} `json:"author"`
-			Record map[string]interface{} `json:"record"`
-		} `json:"post"`
-	} `json:"feed"`
+// session is the shape of createSession/refreshSession responses, and also
+// what gets persisted to sessionFile between restarts.
+type session struct {
+	AccessJwt  string `json:"accessJwt"`
+	RefreshJwt string `json:"refreshJwt"`
+	DID        string `json:"did"`
 }
 
-// New creates a new Bluesky API client.
+// apiError is the shape of an ATProto error response body.
+type apiError struct {
+	Error   string `json:"error"`
+	Message string `json:"message"`
+}
+
+// New creates a new Bluesky API client from a static access token. Prefer
+// NewWithCredentials when you need the session to survive token expiry.
 func New(accessToken string, did string) *blueskyClient {
 	slog.Debug("Initializing Bluesky API client")
 	return &blueskyClient{
+		baseURL:   "https://bsky.social/xrpc",
+		accessJwt: accessToken,
+		did:       did,
+		transport: httpx.New(&http.Client{Timeout: 30 * time.Second}),
+	}
+}
+
+// NewWithCredentials creates a Bluesky client that logs in via
+// com.atproto.server.createSession and transparently refreshes its
+// accessJwt using refreshJwt when it expires. It loads a persisted session
+// from BSKY_SESSION_FILE (or sessionFile) before falling back to a fresh
+// login.
+func NewWithCredentials(identifier, appPassword string) (*blueskyClient, error) {
+	sessionFile := os.Getenv("BSKY_SESSION_FILE")
+	if sessionFile == "" {
+		sessionFile = defaultSessionFile
+	}
+
+	bc := &blueskyClient{
 		baseURL:     "https://bsky.social/xrpc",
-		accessToken: accessToken,
-		did:         did,
-		httpClient: &http.Client{
-			Timeout: 30 * time.Second,
-		},
+		identifier:  identifier,
+		appPassword: appPassword,
+		sessionFile: sessionFile,
+		transport:   httpx.New(&http.Client{Timeout: 30 * time.Second}),
+	}
+
+	if s, err := bc.loadSession(); err == nil {
+		slog.Debug("Restored Bluesky session from disk", "path", sessionFile)
+		bc.accessJwt, bc.refreshJwt, bc.did = s.AccessJwt, s.RefreshJwt, s.DID
+		return bc, nil
+	}
+
+	if err := bc.createSession(context.Background()); err != nil {
+		return nil, fmt.Errorf("failed to create Bluesky session: %w", err)
+	}
+
+	return bc, nil
+}
+
+// RateLimit returns the rate limit info observed on the most recent Bluesky
+// API response, so the scheduler can throttle between requests.
+func (bc *blueskyClient) RateLimit() httpx.RateLimitingInfo {
+	bc.mu.Lock()
+	defer bc.mu.Unlock()
+	return bc.lastRateLimit
+}
+
+// createSession logs in with the configured identifier/app password and
+// stores the resulting tokens.
+func (bc *blueskyClient) createSession(ctx context.Context) error {
+	payload := map[string]string{
+		"identifier": bc.identifier,
+		"password":   bc.appPassword,
+	}
+	payloadBytes, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal createSession payload: %w", err)
 	}
+
+	req, err := http.NewRequest("POST", bc.baseURL+"/com.atproto.server.createSession", bytes.NewBuffer(payloadBytes))
+	if err != nil {
+		return fmt.Errorf("failed to create createSession request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, body, info, err := bc.transport.Do(ctx, req)
+	if err != nil {
+		return fmt.Errorf("createSession request failed: %w", err)
+	}
+	bc.mu.Lock()
+	bc.lastRateLimit = info
+	bc.mu.Unlock()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("createSession returned status %d: %s", resp.StatusCode, body)
+	}
+
+	var s session
+	if err := json.Unmarshal(body, &s); err != nil {
+		return fmt.Errorf("failed to unmarshal createSession response: %w", err)
+	}
+
+	bc.mu.Lock()
+	bc.accessJwt, bc.refreshJwt, bc.did = s.AccessJwt, s.RefreshJwt, s.DID
+	bc.mu.Unlock()
+
+	bc.saveSession(s)
+
+	return nil
+}
+
+// refreshSession exchanges refreshJwt for a new accessJwt/refreshJwt pair.
+func (bc *blueskyClient) refreshSession(ctx context.Context) error {
+	bc.mu.Lock()
+	refreshJwt := bc.refreshJwt
+	bc.mu.Unlock()
+
+	req, err := http.NewRequest("POST", bc.baseURL+"/com.atproto.server.refreshSession", nil)
+	if err != nil {
+		return fmt.Errorf("failed to create refreshSession request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+refreshJwt)
+
+	resp, body, info, err := bc.transport.Do(ctx, req)
+	if err != nil {
+		return fmt.Errorf("refreshSession request failed: %w", err)
+	}
+	bc.mu.Lock()
+	bc.lastRateLimit = info
+	bc.mu.Unlock()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("refreshSession returned status %d: %s", resp.StatusCode, body)
+	}
+
+	var s session
+	if err := json.Unmarshal(body, &s); err != nil {
+		return fmt.Errorf("failed to unmarshal refreshSession response: %w", err)
+	}
+
+	bc.mu.Lock()
+	bc.accessJwt, bc.refreshJwt, bc.did = s.AccessJwt, s.RefreshJwt, s.DID
+	bc.mu.Unlock()
+
+	bc.saveSession(s)
+
+	return nil
+}
+
+func (bc *blueskyClient) saveSession(s session) {
+	if bc.sessionFile == "" {
+		return
+	}
+	data, err := json.Marshal(s)
+	if err != nil {
+		slog.Warn("failed to marshal Bluesky session for persistence", "error", err)
+		return
+	}
+	if err := os.WriteFile(bc.sessionFile, data, 0600); err != nil {
+		slog.Warn("failed to persist Bluesky session", "path", bc.sessionFile, "error", err)
+	}
+}
+
+func (bc *blueskyClient) loadSession() (*session, error) {
+	if bc.sessionFile == "" {
+		return nil, fmt.Errorf("no session file configured")
+	}
+	data, err := os.ReadFile(bc.sessionFile)
+	if err != nil {
+		return nil, err
+	}
+	var s session
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, err
+	}
+	if s.AccessJwt == "" {
+		return nil, fmt.Errorf("persisted session is missing accessJwt")
+	}
+	return &s, nil
+}
+
+// isExpiredTokenResponse reports whether body looks like an ExpiredToken
+// error as returned by com.atproto on a 400.
+func isExpiredTokenResponse(body []byte) bool {
+	var apiErr apiError
+	if err := json.Unmarshal(body, &apiErr); err != nil {
+		return false
+	}
+	return apiErr.Error == "ExpiredToken"
+}
+
+// doAuthenticated sends req with the current accessJwt, refreshing and
+// retrying exactly once on a 401 or an ExpiredToken 400. If the refresh
+// itself fails, it falls back to a fresh createSession and retries once
+// more. The returned body has already been read in full and the response
+// body closed.
+func (bc *blueskyClient) doAuthenticated(ctx context.Context, buildReq func(accessJwt string) (*http.Request, error)) (*http.Response, []byte, error) {
+	bc.mu.Lock()
+	accessJwt := bc.accessJwt
+	bc.mu.Unlock()
+
+	req, err := buildReq(accessJwt)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, body, err := bc.send(ctx, req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	needsRefresh := resp.StatusCode == http.StatusUnauthorized ||
+		(resp.StatusCode == http.StatusBadRequest && isExpiredTokenResponse(body))
+	if !needsRefresh {
+		return resp, body, nil
+	}
+
+	slog.Debug("Bluesky access token expired, refreshing session")
+	if err := bc.refreshSession(ctx); err != nil {
+		slog.Warn("refreshSession failed, re-authenticating from scratch", "error", err)
+		if err := bc.createSession(ctx); err != nil {
+			return nil, nil, fmt.Errorf("failed to re-authenticate after refresh failure: %w", err)
+		}
+	}
+
+	bc.mu.Lock()
+	accessJwt = bc.accessJwt
+	bc.mu.Unlock()
+
+	retryReq, err := buildReq(accessJwt)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create retry request: %w", err)
+	}
+
+	return bc.send(ctx, retryReq)
+}
+
+func (bc *blueskyClient) send(ctx context.Context, req *http.Request) (*http.Response, []byte, error) {
+	resp, body, info, err := bc.transport.Do(ctx, req)
+	if err != nil && resp == nil {
+		return nil, nil, fmt.Errorf("request failed: %w", err)
+	}
+
+	bc.mu.Lock()
+	bc.lastRateLimit = info
+	bc.mu.Unlock()
+
+	return resp, body, nil
 }
 
 // CreatePost creates a new post on Bluesky.
-func (blueskeyClient *blueskyClient) CreatePost(text string) (string, error) {
+func (blueskeyClient *blueskyClient) CreatePost(ctx context.Context, text string) (string, error) {
 	url := fmt.Sprintf("%s/com.atproto.repo.createRecord", blueskeyClient.baseURL)
 
 	record := postRecord{
@@ -78,14 +341,14 @@ func (blueskeyClient *blueskyClient) CreatePost(text string) (string, error) {
 		CreatedAt: time.Now().UTC(),
 	}
 
-	payload := createPostRequest{ 
+	payload := createPostRequest{
 		Repo:       blueskeyClient.did,
 		Collection: "app.bsky.feed.post",
-		Record: map[string]interface{}(map[string]interface{}{
+		Record: map[string]interface{}{
 			"$type":     record.Type,
 			"text":      record.Text,
 			"createdAt": record.CreatedAt.Format(time.RFC3339),
-		}),
+		},
 	}
 
 	payloadBytes, err := json.Marshal(payload)
@@ -94,31 +357,23 @@ func (blueskeyClient *blueskyClient) CreatePost(text string) (string, error) {
 		return "", err
 	}
 
-	req, err := http.NewRequest("POST", url, bytes.NewBuffer(payloadBytes))
-	if err != nil {
-		slog.Error("failed to create request", "error", err)
-		return "", err
-	}
-
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", blueskeyClient.accessToken))
-
-	slog.Debug("Sending CreatePost request to Bluesky API", "method", "POST", "url", url, "payload_size", len(payloadBytes))
-	resp, err := blueskeyClient.httpClient.Do(req)
-	if err != nil {
-		slog.Error("request failed", "error", err)
-		return "", err
-	}
-	defer resp.Body.Close()
-
-	body, err := io.ReadAll(resp.Body)
+	resp, body, err := blueskeyClient.doAuthenticated(ctx, func(accessJwt string) (*http.Request, error) {
+		req, err := http.NewRequest("POST", url, bytes.NewBuffer(payloadBytes))
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+accessJwt)
+		return req, nil
+	})
 	if err != nil {
-		slog.Error("failed to read response", "error", err)
+		slog.Error("CreatePost request failed", "error", err)
 		return "", err
 	}
 
 	slog.Debug("Received response from Bluesky API", "status_code", resp.StatusCode, "body_size", len(body))
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		err := fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, body)
 		slog.Error("unexpected status code", "status_code", resp.StatusCode, "body", string(body))
 		return "", err
 	}
@@ -133,37 +388,29 @@ func (blueskeyClient *blueskyClient) CreatePost(text string) (string, error) {
 }
 
 // FollowUser follows a user on Bluesky.
-func (blueskeyClient *blueskyClient) FollowUser(userHandle string) error {
+func (blueskeyClient *blueskyClient) FollowUser(ctx context.Context, userHandle string) error {
 	// First, resolve the user handle to get their DID
-	url := fmt.Sprintf("%s/com.atproto.identity.resolveHandle", blueskeyClient.baseURL)
-
-	req, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		slog.Error("failed to create resolve request", "error", err)
-		return err
-	}
-
-	q := req.URL.Query()
-	q.Add("handle", userHandle)
-	req.URL.RawQuery = q.Encode()
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", blueskeyClient.accessToken))
-
-	slog.Debug("Sending ResolveHandle request to Bluesky API", "method", "GET", "url", req.URL.String())
-	resp, err := blueskeyClient.httpClient.Do(req)
+	resolveURL := fmt.Sprintf("%s/com.atproto.identity.resolveHandle", blueskeyClient.baseURL)
+
+	resp, body, err := blueskeyClient.doAuthenticated(ctx, func(accessJwt string) (*http.Request, error) {
+		req, err := http.NewRequest("GET", resolveURL, nil)
+		if err != nil {
+			return nil, err
+		}
+		q := req.URL.Query()
+		q.Add("handle", userHandle)
+		req.URL.RawQuery = q.Encode()
+		req.Header.Set("Authorization", "Bearer "+accessJwt)
+		return req, nil
+	})
 	if err != nil {
 		slog.Error("failed to resolve handle", "error", err)
 		return err
 	}
-	defer resp.Body.Close()
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		slog.Error("failed to read resolve response", "error", err)
-		return err
-	}
 
 	slog.Debug("Received response from ResolveHandle request", "status_code", resp.StatusCode, "body_size", len(body))
 	if resp.StatusCode != http.StatusOK {
+		err := fmt.Errorf("failed to resolve handle %s: status %d: %s", userHandle, resp.StatusCode, body)
 		slog.Error("failed to resolve handle", "user_handle", userHandle, "body", string(body))
 		return err
 	}
@@ -197,31 +444,23 @@ func (blueskeyClient *blueskyClient) FollowUser(userHandle string) error {
 		return err
 	}
 
-	followReq, err := http.NewRequest("POST", createFollowURL, bytes.NewBuffer(followBytes))
-	if err != nil {
-		slog.Error("failed to create follow request", "error", err)
-		return err
-	}
-
-	followReq.Header.Set("Content-Type", "application/json")
-	followReq.Header.Set("Authorization", fmt.Sprintf("Bearer %s", blueskeyClient.accessToken))
-
-	slog.Debug("Sending FollowUser request to Bluesky API", "method", "POST", "url", createFollowURL, "payload_size", len(followBytes))
-	followResp, err := blueskeyClient.httpClient.Do(followReq)
+	followResp, followBody, err := blueskeyClient.doAuthenticated(ctx, func(accessJwt string) (*http.Request, error) {
+		req, err := http.NewRequest("POST", createFollowURL, bytes.NewBuffer(followBytes))
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+accessJwt)
+		return req, nil
+	})
 	if err != nil {
 		slog.Error("follow request failed", "error", err)
 		return err
 	}
-	defer followResp.Body.Close()
-
-	followBody, err := io.ReadAll(followResp.Body)
-	if err != nil {
-		slog.Error("failed to read follow response", "error", err)
-		return err
-	}
 
 	slog.Debug("Received response from FollowUser request", "status_code", followResp.StatusCode, "body_size", len(followBody))
 	if followResp.StatusCode != http.StatusOK && followResp.StatusCode != http.StatusCreated {
+		err := fmt.Errorf("unexpected status code %d: %s", followResp.StatusCode, followBody)
 		slog.Error("unexpected status code", "status_code", followResp.StatusCode, "body", string(followBody))
 		return err
 	}
@@ -230,8 +469,7 @@ func (blueskeyClient *blueskyClient) FollowUser(userHandle string) error {
 }
 
 // LikePost likes a post on Bluesky.
-func (blueskeyClient *blueskyClient) LikePost(postURI string) error {
-	// Parse URI to get repo and collection/rkey
+func (blueskeyClient *blueskyClient) LikePost(ctx context.Context, postURI string) error {
 	url := fmt.Sprintf("%s/com.atproto.repo.createRecord", blueskeyClient.baseURL)
 
 	likeRecord := map[string]interface{}{
@@ -254,83 +492,316 @@ func (blueskeyClient *blueskyClient) LikePost(postURI string) error {
 		return err
 	}
 
-	req, err := http.NewRequest("POST", url, bytes.NewBuffer(payloadBytes))
+	resp, body, err := blueskeyClient.doAuthenticated(ctx, func(accessJwt string) (*http.Request, error) {
+		req, err := http.NewRequest("POST", url, bytes.NewBuffer(payloadBytes))
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+accessJwt)
+		return req, nil
+	})
 	if err != nil {
-		slog.Error("failed to create like request", "error", err)
+		slog.Error("like request failed", "error", err)
 		return err
 	}
 
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", blueskeyClient.accessToken))
+	slog.Debug("Received response from LikePost request", "status_code", resp.StatusCode, "body_size", len(body))
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		err := fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, body)
+		slog.Error("unexpected status code", "status_code", resp.StatusCode, "body", string(body))
+		return err
+	}
 
-	slog.Debug("Sending LikePost request to Bluesky API", "method", "POST", "url", url, "payload_size", len(payloadBytes))
-	resp, err := blueskeyClient.httpClient.Do(req)
+	return nil
+}
+
+// GetRecentPosts fetches recent post URIs from the user's feed. It walks the
+// response with fastjson rather than fully unmarshalling it, since only the
+// URI of each entry is needed here.
+func (blueskeyClient *blueskyClient) GetRecentPosts(ctx context.Context, limit int) ([]string, error) {
+	body, err := blueskeyClient.fetchTimeline(ctx, limit)
 	if err != nil {
-		slog.Error("like request failed", "error", err)
-		return err
+		return nil, err
 	}
-	defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
+	parser := blueskeyClient.ParsePool.Get()
+	defer blueskeyClient.ParsePool.Put(parser)
+
+	value, err := parser.ParseBytes(body)
 	if err != nil {
-		slog.Error("failed to read like response", "error", err)
-		return err
+		slog.Error("failed to parse timeline response", "error", err)
+		return nil, err
 	}
 
-	slog.Debug("Received response from LikePost request", "status_code", resp.StatusCode, "body_size", len(body))
-	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+	var postURIs []string
+	for _, item := range value.GetArray("feed") {
+		postURIs = append(postURIs, string(item.GetStringBytes("post", "uri")))
+	}
+
+	return postURIs, nil
+}
+
+// GetRecentPostsDetailed fetches recent posts from the user's feed and
+// returns them as typed Posts, populated directly from the parsed JSON bytes
+// without building intermediate maps.
+func (blueskeyClient *blueskyClient) GetRecentPostsDetailed(ctx context.Context, limit int) ([]Post, error) {
+	body, err := blueskeyClient.fetchTimeline(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	parser := blueskeyClient.ParsePool.Get()
+	defer blueskeyClient.ParsePool.Put(parser)
+
+	value, err := parser.ParseBytes(body)
+	if err != nil {
+		slog.Error("failed to parse timeline response", "error", err)
+		return nil, err
+	}
+
+	var posts []Post
+	for _, item := range value.GetArray("feed") {
+		post := item.Get("post")
+		if post == nil {
+			continue
+		}
+		posts = append(posts, Post{
+			URI:          string(post.GetStringBytes("uri")),
+			CID:          string(post.GetStringBytes("cid")),
+			AuthorHandle: string(post.GetStringBytes("author", "handle")),
+			Text:         string(post.GetStringBytes("record", "text")),
+		})
+	}
+
+	return posts, nil
+}
+
+// SearchActors searches for accounts matching query (e.g. a theme keyword)
+// via app.bsky.actor.searchActors, for the engagement subsystem's follow
+// discovery.
+func (blueskeyClient *blueskyClient) SearchActors(ctx context.Context, query string, limit int) ([]Actor, error) {
+	url := fmt.Sprintf("%s/app.bsky.actor.searchActors", blueskeyClient.baseURL)
+
+	resp, body, err := blueskeyClient.doAuthenticated(ctx, func(accessJwt string) (*http.Request, error) {
+		req, err := http.NewRequest("GET", url, nil)
+		if err != nil {
+			return nil, err
+		}
+		q := req.URL.Query()
+		q.Add("q", query)
+		q.Add("limit", fmt.Sprintf("%d", limit))
+		req.URL.RawQuery = q.Encode()
+		req.Header.Set("Authorization", "Bearer "+accessJwt)
+		return req, nil
+	})
+	if err != nil {
+		slog.Error("SearchActors request failed", "error", err)
+		return nil, err
+	}
+
+	slog.Debug("Received response from SearchActors request", "status_code", resp.StatusCode, "body_size", len(body))
+	if resp.StatusCode != http.StatusOK {
+		err := fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, body)
 		slog.Error("unexpected status code", "status_code", resp.StatusCode, "body", string(body))
-		return err
+		return nil, err
 	}
 
-	return nil
+	return blueskeyClient.parseActors(body, "actors")
 }
 
-// GetRecentPosts fetches recent posts from the user's feed.
-func (blueskeyClient *blueskyClient) GetRecentPosts(limit int) ([]string, error) {
-	url := fmt.Sprintf("%s/app.bsky.feed.getTimeline", blueskeyClient.baseURL)
-	req, err := http.NewRequest("GET", url, nil)
+// GetFollows lists the accounts actor follows via app.bsky.graph.getFollows,
+// used by the engagement subsystem to discover "followers of accounts we
+// already follow" candidates.
+func (blueskeyClient *blueskyClient) GetFollows(ctx context.Context, actor string, limit int) ([]Actor, error) {
+	url := fmt.Sprintf("%s/app.bsky.graph.getFollows", blueskeyClient.baseURL)
+
+	resp, body, err := blueskeyClient.doAuthenticated(ctx, func(accessJwt string) (*http.Request, error) {
+		req, err := http.NewRequest("GET", url, nil)
+		if err != nil {
+			return nil, err
+		}
+		q := req.URL.Query()
+		q.Add("actor", actor)
+		q.Add("limit", fmt.Sprintf("%d", limit))
+		req.URL.RawQuery = q.Encode()
+		req.Header.Set("Authorization", "Bearer "+accessJwt)
+		return req, nil
+	})
 	if err != nil {
-		slog.Error("failed to create request", "error", err)
+		slog.Error("GetFollows request failed", "error", err)
 		return nil, err
 	}
 
-	q := req.URL.Query()
-	q.Add("limit", fmt.Sprintf("%d", limit))
-	req.URL.RawQuery = q.Encode()
+	slog.Debug("Received response from GetFollows request", "status_code", resp.StatusCode, "body_size", len(body))
+	if resp.StatusCode != http.StatusOK {
+		err := fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, body)
+		slog.Error("unexpected status code", "status_code", resp.StatusCode, "body", string(body))
+		return nil, err
+	}
 
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", blueskeyClient.accessToken))
+	return blueskeyClient.parseActors(body, "follows")
+}
 
-	slog.Debug("Sending GetRecentPosts request to Bluesky API", "method", "GET", "url", req.URL.String())
-	resp, err := blueskeyClient.httpClient.Do(req)
+// GetLikes lists the actors who liked postURI via app.bsky.feed.getLikes,
+// used by the engagement subsystem to discover accounts that liked our
+// recent posts as follow candidates. Reposters are not listed.
+func (blueskeyClient *blueskyClient) GetLikes(ctx context.Context, postURI string, limit int) ([]Actor, error) {
+	url := fmt.Sprintf("%s/app.bsky.feed.getLikes", blueskeyClient.baseURL)
+
+	resp, body, err := blueskeyClient.doAuthenticated(ctx, func(accessJwt string) (*http.Request, error) {
+		req, err := http.NewRequest("GET", url, nil)
+		if err != nil {
+			return nil, err
+		}
+		q := req.URL.Query()
+		q.Add("uri", postURI)
+		q.Add("limit", fmt.Sprintf("%d", limit))
+		req.URL.RawQuery = q.Encode()
+		req.Header.Set("Authorization", "Bearer "+accessJwt)
+		return req, nil
+	})
 	if err != nil {
-		slog.Error("request failed", "error", err)
+		slog.Error("GetLikes request failed", "error", err)
 		return nil, err
 	}
-	defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
+	slog.Debug("Received response from GetLikes request", "status_code", resp.StatusCode, "body_size", len(body))
+	if resp.StatusCode != http.StatusOK {
+		err := fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, body)
+		slog.Error("unexpected status code", "status_code", resp.StatusCode, "body", string(body))
+		return nil, err
+	}
+
+	parser := blueskeyClient.ParsePool.Get()
+	defer blueskeyClient.ParsePool.Put(parser)
+
+	value, err := parser.ParseBytes(body)
 	if err != nil {
-		slog.Error("failed to read response", "error", err)
+		slog.Error("failed to parse getLikes response", "error", err)
 		return nil, err
 	}
 
-	slog.Debug("Received response from GetRecentPosts request", "status_code", resp.StatusCode, "body_size", len(body))
+	var actors []Actor
+	for _, like := range value.GetArray("likes") {
+		actor := like.Get("actor")
+		if actor == nil {
+			continue
+		}
+		actors = append(actors, Actor{
+			DID:    string(actor.GetStringBytes("did")),
+			Handle: string(actor.GetStringBytes("handle")),
+		})
+	}
+
+	return actors, nil
+}
+
+// SearchPosts searches recent posts matching query via
+// app.bsky.feed.searchPosts, used by the engagement subsystem's like
+// routine to find theme-matching posts to like.
+func (blueskeyClient *blueskyClient) SearchPosts(ctx context.Context, query string, limit int) ([]Post, error) {
+	url := fmt.Sprintf("%s/app.bsky.feed.searchPosts", blueskeyClient.baseURL)
+
+	resp, body, err := blueskeyClient.doAuthenticated(ctx, func(accessJwt string) (*http.Request, error) {
+		req, err := http.NewRequest("GET", url, nil)
+		if err != nil {
+			return nil, err
+		}
+		q := req.URL.Query()
+		q.Add("q", query)
+		q.Add("limit", fmt.Sprintf("%d", limit))
+		req.URL.RawQuery = q.Encode()
+		req.Header.Set("Authorization", "Bearer "+accessJwt)
+		return req, nil
+	})
+	if err != nil {
+		slog.Error("SearchPosts request failed", "error", err)
+		return nil, err
+	}
+
+	slog.Debug("Received response from SearchPosts request", "status_code", resp.StatusCode, "body_size", len(body))
 	if resp.StatusCode != http.StatusOK {
+		err := fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, body)
 		slog.Error("unexpected status code", "status_code", resp.StatusCode, "body", string(body))
 		return nil, err
 	}
 
-	var feedResp feedResponse
-	if err := json.Unmarshal(body, &feedResp); err != nil {
-		slog.Error("failed to unmarshal response", "error", err)
+	parser := blueskeyClient.ParsePool.Get()
+	defer blueskeyClient.ParsePool.Put(parser)
+
+	value, err := parser.ParseBytes(body)
+	if err != nil {
+		slog.Error("failed to parse searchPosts response", "error", err)
 		return nil, err
 	}
 
-	var postURIs []string
-	for _, post := range feedResp.Feed {
-		postURIs = append(postURIs, post.Post.URI)
+	var posts []Post
+	for _, item := range value.GetArray("posts") {
+		createdAt, _ := time.Parse(time.RFC3339, string(item.GetStringBytes("record", "createdAt")))
+		posts = append(posts, Post{
+			URI:          string(item.GetStringBytes("uri")),
+			CID:          string(item.GetStringBytes("cid")),
+			AuthorHandle: string(item.GetStringBytes("author", "handle")),
+			Text:         string(item.GetStringBytes("record", "text")),
+			Lang:         string(item.GetStringBytes("record", "langs", "0")),
+			CreatedAt:    createdAt,
+		})
 	}
 
-	return postURIs, nil
+	return posts, nil
+}
+
+// parseActors walks a {field: [{did, handle}, ...]} response shape shared
+// by searchActors ("actors") and getFollows ("follows").
+func (blueskeyClient *blueskyClient) parseActors(body []byte, field string) ([]Actor, error) {
+	parser := blueskeyClient.ParsePool.Get()
+	defer blueskeyClient.ParsePool.Put(parser)
+
+	value, err := parser.ParseBytes(body)
+	if err != nil {
+		slog.Error("failed to parse actors response", "error", err)
+		return nil, err
+	}
+
+	var actors []Actor
+	for _, item := range value.GetArray(field) {
+		actors = append(actors, Actor{
+			DID:    string(item.GetStringBytes("did")),
+			Handle: string(item.GetStringBytes("handle")),
+		})
+	}
+
+	return actors, nil
+}
+
+// fetchTimeline performs the authenticated getTimeline request and returns
+// the raw response body for the caller to parse.
+func (blueskeyClient *blueskyClient) fetchTimeline(ctx context.Context, limit int) ([]byte, error) {
+	url := fmt.Sprintf("%s/app.bsky.feed.getTimeline", blueskeyClient.baseURL)
+
+	resp, body, err := blueskeyClient.doAuthenticated(ctx, func(accessJwt string) (*http.Request, error) {
+		req, err := http.NewRequest("GET", url, nil)
+		if err != nil {
+			return nil, err
+		}
+		q := req.URL.Query()
+		q.Add("limit", fmt.Sprintf("%d", limit))
+		req.URL.RawQuery = q.Encode()
+		req.Header.Set("Authorization", "Bearer "+accessJwt)
+		return req, nil
+	})
+	if err != nil {
+		slog.Error("request failed", "error", err)
+		return nil, err
+	}
+
+	slog.Debug("Received response from GetRecentPosts request", "status_code", resp.StatusCode, "body_size", len(body))
+	if resp.StatusCode != http.StatusOK {
+		err := fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, body)
+		slog.Error("unexpected status code", "status_code", resp.StatusCode, "body", string(body))
+		return nil, err
+	}
+
+	return body, nil
 }
@@ -0,0 +1,296 @@
+// Package engagement builds the follow/like candidate pools and budgeted
+// execution that back Scheduler.followRoutine and likeRoutine's Bluesky
+// discovery, on top of the existing watcher-matched Reddit targeting in
+// internal/scheduler. It is Bluesky-specific because the discovery
+// mechanisms it implements -- actor search, post likers, and
+// follows-of-follows -- are ATProto graph concepts with no Reddit
+// equivalent.
+package engagement
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math/rand"
+	"strings"
+	"time"
+
+	"social-agent/internal/social"
+	"social-agent/internal/social/bluesky"
+	"social-agent/internal/store"
+)
+
+// Source is the subset of the Bluesky client the engagement subsystem
+// discovers candidates through. Satisfied structurally by the client
+// returned from bluesky.New/NewWithCredentials.
+type Source interface {
+	SearchActors(ctx context.Context, query string, limit int) ([]bluesky.Actor, error)
+	GetFollows(ctx context.Context, actor string, limit int) ([]bluesky.Actor, error)
+	GetLikes(ctx context.Context, postURI string, limit int) ([]bluesky.Actor, error)
+	SearchPosts(ctx context.Context, query string, limit int) ([]bluesky.Post, error)
+}
+
+// Candidate is an account surfaced by follow discovery, tagged with the
+// mechanism that found it for observability (see FollowReport).
+type Candidate struct {
+	Handle string
+	DID    string
+	Reason string
+}
+
+// Discovery reasons reported on Candidate.Reason.
+const (
+	ReasonThemeSearch        = "theme_search"
+	ReasonLikedRecentPost    = "liked_recent_post"
+	ReasonFollowsOurFollowed = "follows_our_followed"
+)
+
+// FollowConfig bounds how many accounts a single followRoutine run follows
+// and how much it jitters/throttles between them, plus the cooldown that
+// keeps it from re-following (or re-discovering) the same account too soon.
+type FollowConfig struct {
+	// PerDay caps how many accounts one run follows.
+	PerDay int
+	// Jitter is the maximum random extra delay slept between follows, on
+	// top of a fixed 1s floor, so activity doesn't look scripted.
+	Jitter time.Duration
+	// Cooldown is how long a followed (or considered-and-skipped) account
+	// is excluded from future candidate pools.
+	Cooldown time.Duration
+	// SearchLimit/GraphLimit bound how many results each discovery
+	// mechanism requests per call.
+	SearchLimit int
+	GraphLimit  int
+}
+
+// LikeConfig bounds a single likeRoutine run the same way FollowConfig
+// bounds followRoutine, plus the content filters applied before a matching
+// post is liked.
+type LikeConfig struct {
+	PerDay      int
+	Jitter      time.Duration
+	Cooldown    time.Duration
+	SearchLimit int
+	// MaxAge drops posts older than this from consideration; <= 0 disables
+	// the recency filter.
+	MaxAge time.Duration
+	// AllowedLangs restricts candidates with a recorded language to these
+	// BCP-47 codes; a post with no lang recorded always passes, and an
+	// empty list allows any language.
+	AllowedLangs []string
+	// BannedTerms excludes a post whose text contains one of these terms,
+	// case-insensitively -- a coarse toxicity filter in the same spirit as
+	// content.Validator's BannedTerms.
+	BannedTerms []string
+}
+
+// FollowReport summarizes one RunFollow invocation for observability.
+type FollowReport struct {
+	Discovered int
+	Followed   []string
+	Skipped    []string
+	Failed     map[string]string
+}
+
+// LikeReport summarizes one RunLike invocation for observability.
+type LikeReport struct {
+	Discovered int
+	Liked      []string
+	Skipped    []string
+	Failed     map[string]string
+}
+
+// DiscoverFollowCandidates gathers candidates from theme search, the likers
+// of recentPostURIs, and the follows of followedSeeds, de-duplicating by
+// handle and preferring the earliest (and therefore most specific) reason a
+// handle was found under.
+func DiscoverFollowCandidates(ctx context.Context, src Source, theme string, recentPostURIs, followedSeeds []string, cfg FollowConfig, logger *slog.Logger) []Candidate {
+	seen := make(map[string]struct{})
+	var candidates []Candidate
+
+	add := func(actors []bluesky.Actor, reason string) {
+		for _, actor := range actors {
+			if actor.Handle == "" {
+				continue
+			}
+			if _, ok := seen[actor.Handle]; ok {
+				continue
+			}
+			seen[actor.Handle] = struct{}{}
+			candidates = append(candidates, Candidate{Handle: actor.Handle, DID: actor.DID, Reason: reason})
+		}
+	}
+
+	if theme != "" {
+		actors, err := src.SearchActors(ctx, theme, cfg.SearchLimit)
+		if err != nil {
+			logger.Error("failed to search actors for follow discovery", "theme", theme, "error", err)
+		} else {
+			add(actors, ReasonThemeSearch)
+		}
+	}
+
+	for _, uri := range recentPostURIs {
+		actors, err := src.GetLikes(ctx, uri, cfg.GraphLimit)
+		if err != nil {
+			logger.Error("failed to fetch likers for follow discovery", "post_uri", uri, "error", err)
+			continue
+		}
+		add(actors, ReasonLikedRecentPost)
+	}
+
+	for _, seed := range followedSeeds {
+		actors, err := src.GetFollows(ctx, seed, cfg.GraphLimit)
+		if err != nil {
+			logger.Error("failed to fetch follows for follow discovery", "seed", seed, "error", err)
+			continue
+		}
+		add(actors, ReasonFollowsOurFollowed)
+	}
+
+	return candidates
+}
+
+// RunFollow discovers follow candidates and follows up to cfg.PerDay of
+// them, skipping any handle still in cooldown and recording every
+// considered handle into cooldown (followed or not) so a skip doesn't get
+// re-evaluated next run either.
+func RunFollow(ctx context.Context, src Source, poster social.Poster, cooldown store.SeenStore, theme string, recentPostURIs, followedSeeds []string, cfg FollowConfig, logger *slog.Logger) FollowReport {
+	candidates := DiscoverFollowCandidates(ctx, src, theme, recentPostURIs, followedSeeds, cfg, logger)
+	report := FollowReport{Discovered: len(candidates), Failed: make(map[string]string)}
+
+	rand.Shuffle(len(candidates), func(i, j int) { candidates[i], candidates[j] = candidates[j], candidates[i] })
+
+	for _, candidate := range candidates {
+		if len(report.Followed) >= cfg.PerDay {
+			break
+		}
+
+		cooldownKey := cooldownKey("follow", candidate.Handle)
+		if cooldown != nil && cooldown.Seen(cooldownKey) {
+			report.Skipped = append(report.Skipped, candidate.Handle)
+			continue
+		}
+
+		if err := poster.FollowUser(ctx, candidate.Handle); err != nil {
+			logger.Error("failed to follow candidate", "handle", candidate.Handle, "reason", candidate.Reason, "error", err)
+			report.Failed[candidate.Handle] = err.Error()
+			if cooldown != nil {
+				cooldown.Mark(cooldownKey, cfg.Cooldown)
+			}
+			continue
+		}
+
+		logger.Info("followed candidate", "handle", candidate.Handle, "reason", candidate.Reason)
+		report.Followed = append(report.Followed, candidate.Handle)
+		if cooldown != nil {
+			cooldown.Mark(cooldownKey, cfg.Cooldown)
+		}
+
+		sleepWithJitter(ctx, cfg.Jitter)
+	}
+
+	return report
+}
+
+// RunLike searches Bluesky for posts matching theme, filters them by
+// language/recency/banned terms, and likes up to cfg.PerDay of the
+// survivors, recording every liked URI into cooldown to avoid re-liking it.
+func RunLike(ctx context.Context, src Source, poster social.Poster, cooldown store.SeenStore, theme string, cfg LikeConfig, logger *slog.Logger) LikeReport {
+	report := LikeReport{Failed: make(map[string]string)}
+
+	if theme == "" {
+		return report
+	}
+
+	posts, err := src.SearchPosts(ctx, theme, cfg.SearchLimit)
+	if err != nil {
+		logger.Error("failed to search posts for like discovery", "theme", theme, "error", err)
+		return report
+	}
+	report.Discovered = len(posts)
+
+	rand.Shuffle(len(posts), func(i, j int) { posts[i], posts[j] = posts[j], posts[i] })
+
+	for _, post := range posts {
+		if len(report.Liked) >= cfg.PerDay {
+			break
+		}
+
+		if !matchesFilters(post, cfg) {
+			report.Skipped = append(report.Skipped, post.URI)
+			continue
+		}
+
+		cooldownKey := cooldownKey("like", post.URI)
+		if cooldown != nil && cooldown.Seen(cooldownKey) {
+			report.Skipped = append(report.Skipped, post.URI)
+			continue
+		}
+
+		if err := poster.LikePost(ctx, post.URI); err != nil {
+			logger.Error("failed to like post", "post_uri", post.URI, "error", err)
+			report.Failed[post.URI] = err.Error()
+			continue
+		}
+
+		logger.Info("liked post matched by theme search", "post_uri", post.URI, "author", post.AuthorHandle)
+		report.Liked = append(report.Liked, post.URI)
+		if cooldown != nil {
+			cooldown.Mark(cooldownKey, cfg.Cooldown)
+		}
+
+		sleepWithJitter(ctx, cfg.Jitter)
+	}
+
+	return report
+}
+
+// matchesFilters reports whether post survives cfg's recency, language, and
+// banned-term filters.
+func matchesFilters(post bluesky.Post, cfg LikeConfig) bool {
+	if cfg.MaxAge > 0 && !post.CreatedAt.IsZero() && time.Since(post.CreatedAt) > cfg.MaxAge {
+		return false
+	}
+
+	if len(cfg.AllowedLangs) > 0 && post.Lang != "" {
+		allowed := false
+		for _, lang := range cfg.AllowedLangs {
+			if strings.EqualFold(lang, post.Lang) {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return false
+		}
+	}
+
+	lowerText := strings.ToLower(post.Text)
+	for _, term := range cfg.BannedTerms {
+		if term != "" && strings.Contains(lowerText, strings.ToLower(term)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// cooldownKey namespaces a store.SeenStore key by action, since RunFollow
+// and RunLike may share a single cooldown store.
+func cooldownKey(action, id string) string {
+	return fmt.Sprintf("engagement:%s:%s", action, id)
+}
+
+// sleepWithJitter sleeps a fixed 1s floor plus up to jitter more, or
+// returns early if ctx is done.
+func sleepWithJitter(ctx context.Context, jitter time.Duration) {
+	delay := time.Second
+	if jitter > 0 {
+		delay += time.Duration(rand.Int63n(int64(jitter)))
+	}
+	select {
+	case <-ctx.Done():
+	case <-time.After(delay):
+	}
+}
@@ -0,0 +1,370 @@
+// Package mastodon implements a ContentDestination for Mastodon, Pleroma,
+// GoToSocial, and generic ActivityPub servers so posts generated by the
+// agent can be fanned out alongside Bluesky.
+package mastodon
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"social-agent/internal/social/bluesky"
+)
+
+// Ensure activityPubClient satisfies the same destination contract as Bluesky.
+var _ bluesky.ContentDestination = (*activityPubClient)(nil)
+
+type activityPubClient struct {
+	domain     string
+	username   string
+	actorIRI   string
+	privateKey *rsa.PrivateKey
+	httpClient *http.Client
+}
+
+type actor struct {
+	ID        string `json:"id"`
+	Inbox     string `json:"inbox"`
+	Endpoints struct {
+		SharedInbox string `json:"sharedInbox"`
+	} `json:"endpoints"`
+	Followers string `json:"followers"`
+}
+
+type orderedCollection struct {
+	OrderedItems []string `json:"orderedItems"`
+	Items        []string `json:"items"`
+	First        string   `json:"first"`
+}
+
+type webfingerResponse struct {
+	Links []struct {
+		Rel  string `json:"rel"`
+		Type string `json:"type"`
+		Href string `json:"href"`
+	} `json:"links"`
+}
+
+// New creates a new ActivityPub client for the given domain and account
+// username, signing outgoing requests with the RSA key found at keyPath.
+func New(domain, username, keyPath string) (*activityPubClient, error) {
+	keyBytes, err := readPrivateKey(keyPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load ActivityPub private key: %w", err)
+	}
+
+	slog.Info("Initializing ActivityPub client", "domain", domain, "username", username)
+	return &activityPubClient{
+		domain:     domain,
+		username:   username,
+		actorIRI:   fmt.Sprintf("https://%s/users/%s", domain, username),
+		privateKey: keyBytes,
+		httpClient: &http.Client{
+			Timeout: 30 * time.Second,
+		},
+	}, nil
+}
+
+func readPrivateKey(path string) (*rsa.PrivateKey, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	block, _ := pem.Decode(raw)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM block found in %s", path)
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key: %w", err)
+	}
+
+	key, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("private key at %s is not RSA", path)
+	}
+
+	return key, nil
+}
+
+// CreatePost wraps text in a Create+Note activity and delivers it to every
+// shared inbox of our followers.
+func (ap *activityPubClient) CreatePost(ctx context.Context, text string) (string, error) {
+	noteIRI := fmt.Sprintf("%s/notes/%d", ap.actorIRI, time.Now().UnixNano())
+
+	activity := map[string]interface{}{
+		"@context": "https://www.w3.org/ns/activitystreams",
+		"id":       fmt.Sprintf("%s/activity", noteIRI),
+		"type":     "Create",
+		"actor":    ap.actorIRI,
+		"object": map[string]interface{}{
+			"id":           noteIRI,
+			"type":         "Note",
+			"attributedTo": ap.actorIRI,
+			"content":      text,
+			"published":    time.Now().UTC().Format(time.RFC3339),
+			"to":           []string{"https://www.w3.org/ns/activitystreams#Public"},
+		},
+	}
+
+	inboxes, err := ap.followerInboxes(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve follower inboxes: %w", err)
+	}
+
+	for _, inbox := range inboxes {
+		if err := ap.deliver(ctx, inbox, activity); err != nil {
+			slog.Error("failed to deliver Create activity", "inbox", inbox, "error", err)
+		}
+	}
+
+	return noteIRI, nil
+}
+
+// FollowUser resolves acct:user@host via WebFinger and delivers a Follow
+// activity to the remote actor's inbox.
+func (ap *activityPubClient) FollowUser(ctx context.Context, acct string) error {
+	remoteActor, err := ap.resolveActor(ctx, acct)
+	if err != nil {
+		return fmt.Errorf("failed to resolve actor %s: %w", acct, err)
+	}
+
+	activity := map[string]interface{}{
+		"@context": "https://www.w3.org/ns/activitystreams",
+		"id":       fmt.Sprintf("%s/follows/%d", ap.actorIRI, time.Now().UnixNano()),
+		"type":     "Follow",
+		"actor":    ap.actorIRI,
+		"object":   remoteActor.ID,
+	}
+
+	return ap.deliver(ctx, remoteActor.Inbox, activity)
+}
+
+// LikePost posts a Like activity referencing the given note IRI.
+func (ap *activityPubClient) LikePost(ctx context.Context, noteIRI string) error {
+	var note struct {
+		AttributedTo string `json:"attributedTo"`
+	}
+	if err := ap.getJSON(ctx, noteIRI, &note); err != nil {
+		return fmt.Errorf("failed to fetch note %s: %w", noteIRI, err)
+	}
+
+	remoteActor, err := ap.fetchActor(ctx, note.AttributedTo)
+	if err != nil {
+		return fmt.Errorf("failed to resolve inbox for %s: %w", noteIRI, err)
+	}
+
+	activity := map[string]interface{}{
+		"@context": "https://www.w3.org/ns/activitystreams",
+		"id":       fmt.Sprintf("%s/likes/%d", ap.actorIRI, time.Now().UnixNano()),
+		"type":     "Like",
+		"actor":    ap.actorIRI,
+		"object":   noteIRI,
+	}
+
+	return ap.deliver(ctx, remoteActor.Inbox, activity)
+}
+
+// GetRecentPosts returns the IRIs of our own outbox items.
+func (ap *activityPubClient) GetRecentPosts(ctx context.Context, limit int) ([]string, error) {
+	var collection orderedCollection
+	if err := ap.getJSON(ctx, ap.actorIRI+"/outbox", &collection); err != nil {
+		return nil, fmt.Errorf("failed to fetch outbox: %w", err)
+	}
+
+	items := collection.OrderedItems
+	if len(items) > limit {
+		items = items[:limit]
+	}
+
+	return items, nil
+}
+
+func (ap *activityPubClient) followerInboxes(ctx context.Context) ([]string, error) {
+	var collection orderedCollection
+	if err := ap.getJSON(ctx, ap.actorIRI+"/followers", &collection); err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool)
+	var inboxes []string
+	for _, followerIRI := range collection.OrderedItems {
+		remoteActor, err := ap.fetchActor(ctx, followerIRI)
+		if err != nil {
+			slog.Warn("failed to fetch follower actor", "actor", followerIRI, "error", err)
+			continue
+		}
+
+		inbox := remoteActor.Endpoints.SharedInbox
+		if inbox == "" {
+			inbox = remoteActor.Inbox
+		}
+
+		if inbox == "" || seen[inbox] {
+			continue
+		}
+		seen[inbox] = true
+		inboxes = append(inboxes, inbox)
+	}
+
+	return inboxes, nil
+}
+
+func (ap *activityPubClient) resolveActor(ctx context.Context, acct string) (*actor, error) {
+	acct = strings.TrimPrefix(acct, "acct:")
+	parts := strings.SplitN(acct, "@", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid acct %q, expected user@host", acct)
+	}
+	host := parts[1]
+
+	webfingerURL := fmt.Sprintf("https://%s/.well-known/webfinger?resource=acct:%s", host, acct)
+	var wf webfingerResponse
+	if err := ap.getJSON(ctx, webfingerURL, &wf); err != nil {
+		return nil, fmt.Errorf("webfinger lookup failed: %w", err)
+	}
+
+	for _, link := range wf.Links {
+		if link.Rel == "self" {
+			return ap.fetchActor(ctx, link.Href)
+		}
+	}
+
+	return nil, fmt.Errorf("no self link in webfinger response for %s", acct)
+}
+
+func (ap *activityPubClient) fetchActor(ctx context.Context, actorIRI string) (*actor, error) {
+	var a actor
+	if err := ap.getJSON(ctx, actorIRI, &a); err != nil {
+		return nil, err
+	}
+	if a.ID == "" {
+		a.ID = actorIRI
+	}
+	return &a, nil
+}
+
+func (ap *activityPubClient) getJSON(ctx context.Context, url string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", `application/activity+json`)
+
+	if err := ap.sign(req, nil); err != nil {
+		return fmt.Errorf("failed to sign request: %w", err)
+	}
+
+	resp, err := ap.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, body)
+	}
+
+	return json.Unmarshal(body, out)
+}
+
+// deliver POSTs an activity to a remote inbox, signing the request with our
+// RSA key per draft-cavage-http-signatures.
+func (ap *activityPubClient) deliver(ctx context.Context, inbox string, activity map[string]interface{}) error {
+	payload, err := json.Marshal(activity)
+	if err != nil {
+		return fmt.Errorf("failed to marshal activity: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", inbox, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", `application/activity+json`)
+
+	if err := ap.sign(req, payload); err != nil {
+		return fmt.Errorf("failed to sign request: %w", err)
+	}
+
+	resp, err := ap.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("delivery failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("inbox %s returned status %d: %s", inbox, resp.StatusCode, body)
+	}
+
+	return nil
+}
+
+// sign implements HTTP Signatures (draft-cavage-http-signatures) over
+// (request-target), host, date, and digest, as required by most ActivityPub
+// servers to accept federated deliveries.
+func (ap *activityPubClient) sign(req *http.Request, body []byte) error {
+	req.Header.Set("Host", req.URL.Host)
+	req.Header.Set("Date", time.Now().UTC().Format(http.TimeFormat))
+
+	headers := []string{"(request-target)", "host", "date"}
+	if body != nil {
+		sum := sha256.Sum256(body)
+		req.Header.Set("Digest", "SHA-256="+base64.StdEncoding.EncodeToString(sum[:]))
+		headers = append(headers, "digest")
+	}
+
+	signingString := ap.buildSigningString(req, headers)
+
+	digest := sha256.Sum256([]byte(signingString))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, ap.privateKey, crypto.SHA256, digest[:])
+	if err != nil {
+		return err
+	}
+
+	keyID := fmt.Sprintf("%s#main-key", ap.actorIRI)
+	req.Header.Set("Signature", fmt.Sprintf(
+		`keyId="%s",algorithm="rsa-sha256",headers="%s",signature="%s"`,
+		keyID, strings.Join(headers, " "), base64.StdEncoding.EncodeToString(signature),
+	))
+
+	return nil
+}
+
+func (ap *activityPubClient) buildSigningString(req *http.Request, headers []string) string {
+	var lines []string
+	for _, h := range headers {
+		if h == "(request-target)" {
+			target := fmt.Sprintf("%s %s", strings.ToLower(req.Method), req.URL.RequestURI())
+			lines = append(lines, fmt.Sprintf("(request-target): %s", target))
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("%s: %s", h, req.Header.Get(h)))
+	}
+	return strings.Join(lines, "\n")
+}
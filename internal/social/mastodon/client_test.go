@@ -0,0 +1,102 @@
+package mastodon
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func newTestClient(t *testing.T) (*activityPubClient, *rsa.PrivateKey) {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate RSA key: %v", err)
+	}
+
+	keyPath := filepath.Join(t.TempDir(), "key.pem")
+	block := &pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}
+	if err := os.WriteFile(keyPath, pem.EncodeToMemory(block), 0o600); err != nil {
+		t.Fatalf("failed to write key file: %v", err)
+	}
+
+	ap, err := New("example.social", "agent", keyPath)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	return ap, key
+}
+
+func TestSignProducesVerifiableSignature(t *testing.T) {
+	ap, key := newTestClient(t)
+
+	req := httptest.NewRequest(http.MethodPost, "https://remote.example/inbox", nil)
+	payload := []byte(`{"type":"Create"}`)
+
+	if err := ap.sign(req, payload); err != nil {
+		t.Fatalf("sign() error = %v", err)
+	}
+
+	sigHeader := req.Header.Get("Signature")
+	if sigHeader == "" {
+		t.Fatal("sign() did not set a Signature header")
+	}
+	if !strings.Contains(sigHeader, `algorithm="rsa-sha256"`) {
+		t.Errorf("Signature header missing rsa-sha256 algorithm: %q", sigHeader)
+	}
+	if req.Header.Get("Digest") == "" {
+		t.Error("sign() with a non-nil body should set a Digest header")
+	}
+
+	headers := []string{"(request-target)", "host", "date", "digest"}
+	signingString := ap.buildSigningString(req, headers)
+
+	sig := extractParam(t, sigHeader, "signature")
+	sigBytes, err := base64.StdEncoding.DecodeString(sig)
+	if err != nil {
+		t.Fatalf("failed to decode signature: %v", err)
+	}
+
+	digest := sha256.Sum256([]byte(signingString))
+	if err := rsa.VerifyPKCS1v15(&key.PublicKey, crypto.SHA256, digest[:], sigBytes); err != nil {
+		t.Errorf("signature does not verify against the client's own key: %v", err)
+	}
+}
+
+func TestSignOmitsDigestForNilBody(t *testing.T) {
+	ap, _ := newTestClient(t)
+
+	req := httptest.NewRequest(http.MethodGet, "https://remote.example/users/bob", nil)
+	if err := ap.sign(req, nil); err != nil {
+		t.Fatalf("sign() error = %v", err)
+	}
+
+	if req.Header.Get("Digest") != "" {
+		t.Error("sign() with a nil body should not set a Digest header")
+	}
+	if strings.Contains(req.Header.Get("Signature"), "digest") {
+		t.Error("Signature headers list should not include digest when body is nil")
+	}
+}
+
+func extractParam(t *testing.T, header, key string) string {
+	t.Helper()
+	for _, part := range strings.Split(header, ",") {
+		part = strings.TrimSpace(part)
+		if strings.HasPrefix(part, key+`="`) {
+			return strings.TrimSuffix(strings.TrimPrefix(part, key+`="`), `"`)
+		}
+	}
+	t.Fatalf("param %q not found in header %q", key, header)
+	return ""
+}
@@ -0,0 +1,218 @@
+package mastodon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"social-agent/internal/social"
+)
+
+// Visibility values accepted by CreatePostWithVisibility, matching the
+// Mastodon API's status visibility scopes.
+const (
+	VisibilityPublic   = "public"
+	VisibilityUnlisted = "unlisted"
+	VisibilityPrivate  = "private"
+	VisibilityDirect   = "direct"
+)
+
+// mastodonClient talks to the official Mastodon REST API using a user
+// access token, as opposed to activityPubClient's raw ActivityPub
+// federation protocol.
+type mastodonClient struct {
+	instanceURL       string
+	accessToken       string
+	defaultVisibility string
+	httpClient        *http.Client
+}
+
+// Ensure mastodonClient satisfies the shared cross-network contract the
+// scheduler fans posts out through.
+var _ social.Poster = (*mastodonClient)(nil)
+
+type statusResponse struct {
+	ID string `json:"id"`
+}
+
+type accountLookupResponse struct {
+	ID string `json:"id"`
+}
+
+// NewMastodonClient creates a Mastodon REST API client against instanceURL
+// (e.g. "https://mastodon.social"), authenticating with a user access
+// token. CreatePost uses VisibilityPublic; use CreatePostWithVisibility to
+// post with a different scope.
+func NewMastodonClient(instanceURL, accessToken string) *mastodonClient {
+	slog.Info("Initializing Mastodon API client", "instance", instanceURL)
+	return &mastodonClient{
+		instanceURL:       strings.TrimRight(instanceURL, "/"),
+		accessToken:       accessToken,
+		defaultVisibility: VisibilityPublic,
+		httpClient:        &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// CreatePost publishes text as a new public status, satisfying
+// social.Poster. Use CreatePostWithVisibility for a non-public scope.
+func (mc *mastodonClient) CreatePost(ctx context.Context, text string) (string, error) {
+	return mc.CreatePostWithVisibility(ctx, text, mc.defaultVisibility)
+}
+
+// CreatePostWithVisibility publishes text as a new status with an explicit
+// visibility (VisibilityPublic, VisibilityUnlisted, VisibilityPrivate, or
+// VisibilityDirect), so per-post scope isn't lost behind social.Poster's
+// fixed CreatePost signature.
+func (mc *mastodonClient) CreatePostWithVisibility(ctx context.Context, text, visibility string) (string, error) {
+	form := url.Values{}
+	form.Set("status", text)
+	form.Set("visibility", visibility)
+
+	req, err := http.NewRequestWithContext(ctx, "POST", mc.instanceURL+"/api/v1/statuses", strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Authorization", "Bearer "+mc.accessToken)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	body, err := mc.do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to create status: %w", err)
+	}
+
+	var parsed statusResponse
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return "", fmt.Errorf("failed to decode create status response: %w", err)
+	}
+
+	return parsed.ID, nil
+}
+
+// FavouritePost favourites (likes) the status identified by id.
+func (mc *mastodonClient) FavouritePost(ctx context.Context, id string) error {
+	favouriteURL := fmt.Sprintf("%s/api/v1/statuses/%s/favourite", mc.instanceURL, id)
+	req, err := http.NewRequestWithContext(ctx, "POST", favouriteURL, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+mc.accessToken)
+
+	if _, err := mc.do(req); err != nil {
+		return fmt.Errorf("failed to favourite status %s: %w", id, err)
+	}
+	return nil
+}
+
+// LikePost favourites the status identified by postID, satisfying
+// social.Poster.
+func (mc *mastodonClient) LikePost(ctx context.Context, postID string) error {
+	return mc.FavouritePost(ctx, postID)
+}
+
+// FollowAccount resolves acct (a local username or user@domain handle) and
+// follows it.
+func (mc *mastodonClient) FollowAccount(ctx context.Context, acct string) error {
+	accountID, err := mc.resolveAccount(ctx, acct)
+	if err != nil {
+		return fmt.Errorf("failed to resolve account %q: %w", acct, err)
+	}
+
+	followURL := fmt.Sprintf("%s/api/v1/accounts/%s/follow", mc.instanceURL, accountID)
+	req, err := http.NewRequestWithContext(ctx, "POST", followURL, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+mc.accessToken)
+
+	if _, err := mc.do(req); err != nil {
+		return fmt.Errorf("failed to follow %s: %w", acct, err)
+	}
+	return nil
+}
+
+// FollowUser follows userHandle, satisfying social.Poster.
+func (mc *mastodonClient) FollowUser(ctx context.Context, userHandle string) error {
+	return mc.FollowAccount(ctx, userHandle)
+}
+
+func (mc *mastodonClient) resolveAccount(ctx context.Context, acct string) (string, error) {
+	lookupURL := fmt.Sprintf("%s/api/v1/accounts/lookup?acct=%s", mc.instanceURL, url.QueryEscape(acct))
+	req, err := http.NewRequestWithContext(ctx, "GET", lookupURL, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Authorization", "Bearer "+mc.accessToken)
+
+	body, err := mc.do(req)
+	if err != nil {
+		return "", err
+	}
+
+	var parsed accountLookupResponse
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return "", fmt.Errorf("failed to decode account lookup response: %w", err)
+	}
+	if parsed.ID == "" {
+		return "", fmt.Errorf("account lookup response missing id")
+	}
+
+	return parsed.ID, nil
+}
+
+// GetHomeTimeline fetches up to limit statuses from the authenticated
+// user's home timeline, returning their IDs.
+func (mc *mastodonClient) GetHomeTimeline(ctx context.Context, limit int) ([]string, error) {
+	timelineURL := fmt.Sprintf("%s/api/v1/timelines/home?limit=%d", mc.instanceURL, limit)
+	req, err := http.NewRequestWithContext(ctx, "GET", timelineURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+mc.accessToken)
+
+	body, err := mc.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch home timeline: %w", err)
+	}
+
+	var parsed []statusResponse
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return nil, fmt.Errorf("failed to decode home timeline response: %w", err)
+	}
+
+	ids := make([]string, len(parsed))
+	for i, status := range parsed {
+		ids[i] = status.ID
+	}
+	return ids, nil
+}
+
+// GetRecentPosts returns up to limit status IDs from the home timeline,
+// satisfying social.Poster.
+func (mc *mastodonClient) GetRecentPosts(ctx context.Context, limit int) ([]string, error) {
+	return mc.GetHomeTimeline(ctx, limit)
+}
+
+func (mc *mastodonClient) do(req *http.Request) ([]byte, error) {
+	resp, err := mc.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, body)
+	}
+
+	return body, nil
+}
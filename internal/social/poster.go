@@ -0,0 +1,15 @@
+// Package social holds interfaces shared across social network clients
+// (Bluesky, Mastodon, ...) that live in its subpackages.
+package social
+
+import "context"
+
+// Poster is the common destination contract every social network client
+// satisfies, letting the scheduler fan a single generated post out to
+// every configured network without caring which one it's talking to.
+type Poster interface {
+	CreatePost(ctx context.Context, text string) (string, error)
+	LikePost(ctx context.Context, postID string) error
+	FollowUser(ctx context.Context, userHandle string) error
+	GetRecentPosts(ctx context.Context, limit int) ([]string, error)
+}
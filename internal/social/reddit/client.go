@@ -0,0 +1,292 @@
+// Package reddit implements a ContentSource backed by the official Reddit
+// API so the agent can draw on subreddit content alongside Twitter.
+package reddit
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/valyala/fastjson"
+
+	"social-agent/internal/httpx"
+	"social-agent/internal/store"
+)
+
+// Post represents a post pulled from a subreddit.
+type Post struct {
+	ID        string
+	Title     string
+	Content   string
+	Author    string
+	URL       string
+	CreatedAt time.Time
+	Source    string
+	Upvotes   int
+	Flair     string
+	Subreddit string
+}
+
+// ContentSource defines the interface for getting content from Reddit.
+type ContentSource interface {
+	FetchRecent(ctx context.Context, maxAgeDays int) ([]*Post, error)
+}
+
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int    `json:"expires_in"`
+}
+
+type aboutResponse struct {
+	Data struct {
+		Name        string `json:"name"` // t5_ fullname
+		DisplayName string `json:"display_name"`
+	} `json:"data"`
+}
+
+type redditClient struct {
+	clientID     string
+	clientSecret string
+	username     string
+	password     string
+	userAgent    string
+	transport    *httpx.Transport
+	ParsePool    fastjson.ParserPool
+
+	// SeenStore, if set, filters already-consumed posts out of FetchRecent
+	// before they ever reach the generator.
+	SeenStore store.SeenStore
+
+	accessToken   string
+	tokenExpiry   time.Time
+	lastRateLimit httpx.RateLimitingInfo
+
+	// subreddits holds the display names configured at startup.
+	subreddits []string
+	// fullnames maps a subreddit display name to its resolved t5_ fullname,
+	// populated once at construction time.
+	fullnames map[string]string
+}
+
+// New creates a new Reddit ContentSource, authenticating via OAuth2 password
+// grant and resolving every configured subreddit to its canonical t5_
+// fullname so a misspelled or renamed subreddit fails fast at startup
+// instead of silently returning nothing on every fetch.
+func New(clientID, clientSecret, username, password, userAgent string, subreddits []string) (*redditClient, error) {
+	rc := &redditClient{
+		clientID:     clientID,
+		clientSecret: clientSecret,
+		username:     username,
+		password:     password,
+		userAgent:    userAgent,
+		subreddits:   subreddits,
+		fullnames:    make(map[string]string),
+		transport:    httpx.New(&http.Client{Timeout: 30 * time.Second}),
+	}
+
+	if err := rc.authenticate(context.Background()); err != nil {
+		return nil, fmt.Errorf("failed to authenticate with Reddit: %w", err)
+	}
+
+	if err := rc.resolveSubreddits(context.Background()); err != nil {
+		return nil, fmt.Errorf("failed to resolve configured subreddits: %w", err)
+	}
+
+	return rc, nil
+}
+
+func (rc *redditClient) authenticate(ctx context.Context) error {
+	auth := rc.clientID + ":" + rc.clientSecret
+	encodedAuth := base64.StdEncoding.EncodeToString([]byte(auth))
+
+	data := url.Values{}
+	data.Set("grant_type", "password")
+	data.Set("username", rc.username)
+	data.Set("password", rc.password)
+
+	req, err := http.NewRequest("POST", "https://www.reddit.com/api/v1/access_token", bytes.NewBufferString(data.Encode()))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Authorization", "Basic "+encodedAuth)
+	req.Header.Set("User-Agent", rc.userAgent)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, body, info, err := rc.transport.Do(ctx, req)
+	if err != nil {
+		return fmt.Errorf("authentication request failed: %w", err)
+	}
+	rc.lastRateLimit = info
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, body)
+	}
+
+	var tok tokenResponse
+	if err := json.Unmarshal(body, &tok); err != nil {
+		return fmt.Errorf("failed to decode auth response: %w", err)
+	}
+
+	if tok.AccessToken == "" {
+		return fmt.Errorf("failed to obtain access token")
+	}
+
+	rc.accessToken = tok.AccessToken
+	rc.tokenExpiry = time.Now().Add(time.Duration(tok.ExpiresIn) * time.Second)
+
+	return nil
+}
+
+func (rc *redditClient) ensureAuthenticated(ctx context.Context) error {
+	if rc.accessToken == "" || time.Now().After(rc.tokenExpiry) {
+		return rc.authenticate(ctx)
+	}
+	return nil
+}
+
+// RateLimit returns the rate limit info observed on the most recent Reddit
+// API response, so the scheduler can throttle between requests.
+func (rc *redditClient) RateLimit() httpx.RateLimitingInfo {
+	return rc.lastRateLimit
+}
+
+// resolveSubreddits looks up the canonical t5_ fullname for every configured
+// subreddit via /r/{name}/about, caching the mapping for the lifetime of the
+// client.
+func (rc *redditClient) resolveSubreddits(ctx context.Context) error {
+	for _, name := range rc.subreddits {
+		fullname, err := rc.resolveSubreddit(ctx, name)
+		if err != nil {
+			return fmt.Errorf("subreddit %q: %w", name, err)
+		}
+		rc.fullnames[name] = fullname
+		slog.Debug("resolved subreddit", "subreddit", name, "fullname", fullname)
+	}
+	return nil
+}
+
+func (rc *redditClient) resolveSubreddit(ctx context.Context, name string) (string, error) {
+	aboutURL := fmt.Sprintf("https://oauth.reddit.com/r/%s/about", name)
+	req, err := http.NewRequest("GET", aboutURL, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Authorization", "Bearer "+rc.accessToken)
+	req.Header.Set("User-Agent", rc.userAgent)
+
+	resp, body, info, err := rc.transport.Do(ctx, req)
+	if err != nil {
+		if errors.Is(err, httpx.ErrNotFound) {
+			return "", fmt.Errorf("subreddit does not exist or was renamed")
+		}
+		return "", fmt.Errorf("request failed: %w", err)
+	}
+	rc.lastRateLimit = info
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, body)
+	}
+
+	var about aboutResponse
+	if err := json.Unmarshal(body, &about); err != nil {
+		return "", fmt.Errorf("failed to decode about response: %w", err)
+	}
+
+	if about.Data.Name == "" {
+		return "", fmt.Errorf("about response missing fullname")
+	}
+
+	return about.Data.Name, nil
+}
+
+// FetchRecent retrieves posts newer than maxAgeDays from every configured
+// subreddit.
+func (rc *redditClient) FetchRecent(ctx context.Context, maxAgeDays int) ([]*Post, error) {
+	if err := rc.ensureAuthenticated(ctx); err != nil {
+		return nil, fmt.Errorf("failed to authenticate: %w", err)
+	}
+
+	cutoff := time.Now().AddDate(0, 0, -maxAgeDays)
+
+	var posts []*Post
+	for _, name := range rc.subreddits {
+		subredditPosts, err := rc.fetchSubreddit(ctx, name)
+		if err != nil {
+			slog.Error("failed to fetch subreddit", "subreddit", name, "error", err)
+			continue
+		}
+
+		for _, post := range subredditPosts {
+			if !post.CreatedAt.After(cutoff) {
+				continue
+			}
+			if rc.SeenStore != nil {
+				if rc.SeenStore.Seen(post.ID) {
+					continue
+				}
+				rc.SeenStore.Mark(post.ID, store.DefaultTTL)
+			}
+			posts = append(posts, post)
+		}
+	}
+
+	return posts, nil
+}
+
+func (rc *redditClient) fetchSubreddit(ctx context.Context, name string) ([]*Post, error) {
+	listingURL := fmt.Sprintf("https://oauth.reddit.com/r/%s/hot?limit=25", name)
+	req, err := http.NewRequestWithContext(ctx, "GET", listingURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+rc.accessToken)
+	req.Header.Set("User-Agent", rc.userAgent)
+
+	resp, body, info, err := rc.transport.Do(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	rc.lastRateLimit = info
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, body)
+	}
+
+	parser := rc.ParsePool.Get()
+	defer rc.ParsePool.Put(parser)
+
+	listing, err := parser.ParseBytes(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse listing response: %w", err)
+	}
+
+	var posts []*Post
+	for _, child := range listing.GetArray("data", "children") {
+		data := child.Get("data")
+		if data == nil {
+			continue
+		}
+		posts = append(posts, &Post{
+			ID:        rc.fullnames[name] + "_" + string(data.GetStringBytes("id")),
+			Title:     string(data.GetStringBytes("title")),
+			Content:   string(data.GetStringBytes("selftext")),
+			Author:    string(data.GetStringBytes("author")),
+			URL:       "https://reddit.com" + string(data.GetStringBytes("permalink")),
+			CreatedAt: time.Unix(int64(data.GetFloat64("created_utc")), 0),
+			Source:    "Reddit",
+			Upvotes:   data.GetInt("ups"),
+			Flair:     string(data.GetStringBytes("link_flair_text")),
+			Subreddit: name,
+		})
+	}
+
+	return posts, nil
+}
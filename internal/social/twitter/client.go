@@ -1,13 +1,19 @@
 package twitter
 
 import (
-	"encoding/json"
-	"io"
+	"context"
+	"errors"
+	"fmt"
 	"log/slog"
 	"net/http"
 	"net/url"
 	"strconv"
 	"time"
+
+	"github.com/valyala/fastjson"
+
+	"social-agent/internal/httpx"
+	"social-agent/internal/store"
 )
 
 const TwitterSearchURL = "https://api.twitter.com/2/tweets/search/recent"
@@ -15,31 +21,76 @@ const TwitterClientTimeout = 30 * time.Second
 
 // Post represents a post from a content source
 type Post struct {
+	ID        string
 	Content   string
+	Author    string
+	URL       string
+	CreatedAt time.Time
 	Source    string
 }
 
 // ContentSource defines the interface for getting content from a source
 type ContentSource interface {
-	QueryWorkRantTweets(limit int) ([]Post, error)
+	QueryWorkRantTweets(ctx context.Context, limit int) ([]Post, error)
+	QueryTweets(ctx context.Context, opts QueryOptions) ([]Post, error)
 }
 
-type tweetResponse struct {
-	Data []struct {
-		Text      string `json:"text"`
-		CreatedAt string `json:"created_at"`
-	} `json:"data"`
-	Meta struct {
-		ResultCount int    `json:"result_count"`
-		NewestID    string `json:"newest_id"`
-		OldestID    string `json:"oldest_id"`
-	} `json:"meta"`
+// QueryOptions configures a single QueryTweets call.
+type QueryOptions struct {
+	// Query is the Twitter v2 search query string; defaults to
+	// WorkRantQuery when empty.
+	Query string
+	// MaxResultsPerPage caps how many tweets a single page request returns
+	// (the Twitter v2 API accepts 10-100); defaults to 10.
+	MaxResultsPerPage int
+	// TotalCap caps how many tweets QueryTweets returns in total, across
+	// however many pages it has to fetch; defaults to MaxResultsPerPage.
+	TotalCap int
+	// SinceID restricts results to tweets newer than SinceID. If empty,
+	// QueryTweets fills it in from the twitterClient's own NewestID, so
+	// repeated calls only ever fetch tweets the previous call hadn't seen.
+	SinceID string
+	// UntilID restricts results to tweets older than UntilID.
+	UntilID string
+}
+
+func (o QueryOptions) withDefaults() QueryOptions {
+	if o.Query == "" {
+		o.Query = WorkRantQuery
+	}
+	if o.MaxResultsPerPage <= 0 {
+		o.MaxResultsPerPage = 10
+	}
+	if o.TotalCap <= 0 {
+		o.TotalCap = o.MaxResultsPerPage
+	}
+	return o
 }
 
 type twitterClient struct {
-	bearerToken  string
-	searchURL    string
-	httpClient   *http.Client
+	bearerToken   string
+	searchURL     string
+	transport     *httpx.Transport
+	ParsePool     fastjson.ParserPool
+	lastRateLimit httpx.RateLimitingInfo
+
+	// newestID is the ID of the newest tweet QueryTweets has returned, used
+	// as the next call's since_id so the scheduler's polling cycle only
+	// ever fetches tweets it hasn't already seen.
+	newestID string
+
+	// SeenStore, if set, filters already-consumed tweets out of
+	// QueryWorkRantTweets before they ever reach the generator.
+	SeenStore store.SeenStore
+
+	// OAuth1 user-context credentials, set only by NewTwitterOAuth1Client.
+	// PostTweet/LikeTweet/FollowUser require these; QueryWorkRantTweets
+	// does not and continues to use the app-only bearer token above.
+	consumerKey    string
+	consumerSecret string
+	accessToken    string
+	accessSecret   string
+	userID         string
 }
 
 // New creates a new Twitter API client.
@@ -48,70 +99,239 @@ func New(bearerToken string) *twitterClient {
 	return &twitterClient{
 		bearerToken: bearerToken,
 		searchURL:   TwitterSearchURL,
-		httpClient: &http.Client{
+		transport: httpx.New(&http.Client{
+			Timeout: TwitterClientTimeout,
+		}),
+	}
+}
+
+// NewTwitterOAuth1Client creates a Twitter client authenticated via OAuth1
+// user context (consumer key/secret + access token/secret) instead of an
+// app-only bearer token, unlocking the write endpoints used by PostTweet,
+// LikeTweet, and FollowUser.
+func NewTwitterOAuth1Client(consumerKey, consumerSecret, accessToken, accessSecret string) *twitterClient {
+	slog.Info("Initializing Twitter API client with OAuth1 user context")
+	twitterClient := &twitterClient{
+		consumerKey:    consumerKey,
+		consumerSecret: consumerSecret,
+		accessToken:    accessToken,
+		accessSecret:   accessSecret,
+		searchURL:      TwitterSearchURL,
+		transport: httpx.New(&http.Client{
 			Timeout: TwitterClientTimeout,
-		},
+		}),
 	}
+	// Sign, not a pre-built Authorization header, so a retried request
+	// carries a fresh oauth_nonce/oauth_timestamp instead of replaying the
+	// first attempt's.
+	twitterClient.transport.Sign = twitterClient.signRequest
+	return twitterClient
+}
+
+// RateLimit returns the rate limit info observed on the most recent Twitter
+// API response, so the scheduler can throttle between requests.
+func (twitterClient *twitterClient) RateLimit() httpx.RateLimitingInfo {
+	return twitterClient.lastRateLimit
+}
+
+// NewestID returns the ID of the newest tweet QueryTweets has returned so
+// far, or "" if QueryTweets has never been called.
+func (twitterClient *twitterClient) NewestID() string {
+	return twitterClient.newestID
 }
 
 // QueryWorkRantTweets retrieves recent work-related rants from Twitter.
 // It searches for tweets containing keywords about work frustrations.
-func (twitterClient *twitterClient) QueryWorkRantTweets(limit int) ([]Post, error) {
+func (twitterClient *twitterClient) QueryWorkRantTweets(ctx context.Context, limit int) ([]Post, error) {
 	// Build query url
 	params := url.Values{}
-	query := "(work OR job OR boss OR office OR coworker OR meeting OR deadline) (rant OR frustrated OR tired OR hate OR awful OR nightmare) lang:en -is:retweet -filter:videos"
-	params.Add("query", query)
+	params.Add("query", WorkRantQuery)
 	params.Add("max_results", strconv.Itoa(limit))
-	params.Add("tweet.fields", "created_at")
+	params.Add("tweet.fields", "created_at,id")
 	url := twitterClient.searchURL + "?" + params.Encode()
 
 	// Send request
-	request, err := http.NewRequest("GET", url, nil)
+	request, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
-		slog.Error("Failed to create request to Twitter API","error", err)
+		slog.Error("Failed to create request to Twitter API", "error", err)
 		return nil, err
 	}
 	request.Header.Set("Authorization", "Bearer "+twitterClient.bearerToken)
 	slog.Debug("Sending request to Twitter API", "method", "GET", "url", url)
-	response, err := twitterClient.httpClient.Do(request)
+	response, body, info, err := twitterClient.transport.Do(ctx, request)
 	if err != nil {
 		slog.Error("Request to Twitter API failed", "error", err)
 		return nil, err
 	}
-	defer request.Body.Close()
+	twitterClient.lastRateLimit = info
 
 	// Verify and parse response
 	if response.StatusCode != http.StatusOK {
 		slog.Error("Twitter API returned unexpected status code", "status_code", response.StatusCode)
-		return nil, err
-	}
-	body, err := io.ReadAll(response.Body)
-	if err != nil {
-		slog.Error("Failed to read response body from Twitter API", "error", err)
-		return nil, err
+		return nil, fmt.Errorf("unexpected status code %d: %s", response.StatusCode, body)
 	}
 	slog.Debug("Received response from Twitter API", "status_code", response.StatusCode, "body_size", len(body))
-	var parsedResponse tweetResponse
-	if err := json.Unmarshal(body, &parsedResponse); err != nil {
-		slog.Error("Failed to decode Twitter API response: %v", err)
+
+	parser := twitterClient.ParsePool.Get()
+	defer twitterClient.ParsePool.Put(parser)
+
+	parsedResponse, err := parser.ParseBytes(body)
+	if err != nil {
+		slog.Error("Failed to parse Twitter API response", "error", err)
 		return nil, err
 	}
 
 	// Store posts
 	var posts []Post
-	for _, tweet := range parsedResponse.Data {
-		createdAt, err := time.Parse(time.RFC3339, tweet.CreatedAt)
+	for _, tweet := range parsedResponse.GetArray("data") {
+		createdAtRaw := string(tweet.GetStringBytes("created_at"))
+		createdAt, err := time.Parse(time.RFC3339, createdAtRaw)
 		if err != nil {
-			slog.Warn("Failed to parse tweet created_at timestamp: ", tweet.CreatedAt, "error: ", err)
+			slog.Warn("Failed to parse tweet created_at timestamp", "value", createdAtRaw, "error", err)
 			continue
 		}
 		post := Post{
-			Content:   tweet.Text,
+			ID:        string(tweet.GetStringBytes("id")),
+			Content:   string(tweet.GetStringBytes("text")),
 			Source:    "Twitter",
 			CreatedAt: createdAt,
 		}
+
+		if twitterClient.SeenStore != nil {
+			if twitterClient.SeenStore.Seen(post.ID) {
+				continue
+			}
+			twitterClient.SeenStore.Mark(post.ID, store.DefaultTTL)
+		}
+
 		posts = append(posts, post)
 	}
 
 	return posts, nil
 }
+
+// QueryTweets searches for tweets matching opts, following the Twitter v2
+// API's pagination_token/next_token cursor across pages until TotalCap
+// tweets have been collected or a page comes back without a next_token. It
+// requests the author_id expansion so returned posts carry the author's
+// username, and updates the client's NewestID from the first (newest)
+// tweet of the first page, so the caller's next QueryTweets call (with
+// SinceID left empty) only sees tweets this one hadn't.
+func (twitterClient *twitterClient) QueryTweets(ctx context.Context, opts QueryOptions) ([]Post, error) {
+	opts = opts.withDefaults()
+	if opts.SinceID == "" {
+		opts.SinceID = twitterClient.newestID
+	}
+
+	var posts []Post
+	var newestSeen string
+	paginationToken := ""
+
+	for len(posts) < opts.TotalCap {
+		pageSize := opts.TotalCap - len(posts)
+		if pageSize > opts.MaxResultsPerPage {
+			pageSize = opts.MaxResultsPerPage
+		}
+
+		params := url.Values{}
+		params.Add("query", opts.Query)
+		params.Add("max_results", strconv.Itoa(pageSize))
+		params.Add("tweet.fields", "created_at,id,author_id")
+		params.Add("expansions", "author_id")
+		params.Add("user.fields", "username,name,description")
+		if opts.SinceID != "" {
+			params.Add("since_id", opts.SinceID)
+		}
+		if opts.UntilID != "" {
+			params.Add("until_id", opts.UntilID)
+		}
+		if paginationToken != "" {
+			params.Add("pagination_token", paginationToken)
+		}
+		reqURL := twitterClient.searchURL + "?" + params.Encode()
+
+		request, err := http.NewRequestWithContext(ctx, "GET", reqURL, nil)
+		if err != nil {
+			return posts, fmt.Errorf("failed to create request to Twitter API: %w", err)
+		}
+		request.Header.Set("Authorization", "Bearer "+twitterClient.bearerToken)
+
+		response, body, info, err := twitterClient.transport.Do(ctx, request)
+		twitterClient.lastRateLimit = info
+		if err != nil {
+			switch {
+			case errors.Is(err, httpx.ErrRateLimited):
+				return posts, fmt.Errorf("twitter search rate limited: %w", err)
+			case errors.Is(err, httpx.ErrAuthRevoked):
+				return posts, fmt.Errorf("twitter search authentication failed: %w", err)
+			default:
+				return posts, fmt.Errorf("twitter search failed: %w", err)
+			}
+		}
+		if response.StatusCode != http.StatusOK {
+			return posts, fmt.Errorf("unexpected status code %d: %s", response.StatusCode, body)
+		}
+
+		parser := twitterClient.ParsePool.Get()
+		parsedResponse, err := parser.ParseBytes(body)
+		if err != nil {
+			twitterClient.ParsePool.Put(parser)
+			return posts, fmt.Errorf("failed to parse Twitter API response: %w", err)
+		}
+
+		usernames := make(map[string]string)
+		for _, user := range parsedResponse.GetArray("includes", "users") {
+			usernames[string(user.GetStringBytes("id"))] = string(user.GetStringBytes("username"))
+		}
+
+		page := parsedResponse.GetArray("data")
+		for _, tweet := range page {
+			createdAtRaw := string(tweet.GetStringBytes("created_at"))
+			createdAt, err := time.Parse(time.RFC3339, createdAtRaw)
+			if err != nil {
+				slog.Warn("Failed to parse tweet created_at timestamp", "value", createdAtRaw, "error", err)
+				continue
+			}
+
+			id := string(tweet.GetStringBytes("id"))
+			authorID := string(tweet.GetStringBytes("author_id"))
+
+			if twitterClient.SeenStore != nil {
+				if twitterClient.SeenStore.Seen(id) {
+					continue
+				}
+				twitterClient.SeenStore.Mark(id, store.DefaultTTL)
+			}
+
+			if newestSeen == "" {
+				newestSeen = id
+			}
+
+			posts = append(posts, Post{
+				ID:        id,
+				Content:   string(tweet.GetStringBytes("text")),
+				Author:    usernames[authorID],
+				Source:    "Twitter",
+				CreatedAt: createdAt,
+			})
+
+			if len(posts) >= opts.TotalCap {
+				break
+			}
+		}
+
+		nextToken := string(parsedResponse.GetStringBytes("meta", "next_token"))
+		twitterClient.ParsePool.Put(parser)
+
+		if nextToken == "" || len(page) == 0 {
+			break
+		}
+		paginationToken = nextToken
+	}
+
+	if newestSeen != "" {
+		twitterClient.newestID = newestSeen
+	}
+
+	return posts, nil
+}
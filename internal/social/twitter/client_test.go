@@ -0,0 +1,175 @@
+package twitter
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// pagedTweet is one synthetic tweet served by the test server below.
+type pagedTweet struct {
+	ID        string
+	AuthorID  string
+	Username  string
+	CreatedAt string
+}
+
+// newPaginatedServer serves pages of tweets two at a time, following the
+// Twitter v2 meta.next_token cursoring convention, so QueryTweets's paging
+// loop can be exercised end-to-end.
+func newPaginatedServer(t *testing.T, tweets []pagedTweet) *httptest.Server {
+	t.Helper()
+	const pageSize = 2
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		start := 0
+		if tok := q.Get("pagination_token"); tok != "" {
+			var err error
+			start, err = parsePageToken(tok)
+			if err != nil {
+				http.Error(w, "bad pagination_token", http.StatusBadRequest)
+				return
+			}
+		}
+
+		end := start + pageSize
+		if end > len(tweets) {
+			end = len(tweets)
+		}
+		page := tweets[start:end]
+
+		data := make([]map[string]interface{}, 0, len(page))
+		users := make(map[string]map[string]interface{})
+		for _, tw := range page {
+			data = append(data, map[string]interface{}{
+				"id":         tw.ID,
+				"text":       "post " + tw.ID,
+				"created_at": tw.CreatedAt,
+				"author_id":  tw.AuthorID,
+			})
+			users[tw.AuthorID] = map[string]interface{}{"id": tw.AuthorID, "username": tw.Username}
+		}
+		userList := make([]map[string]interface{}, 0, len(users))
+		for _, u := range users {
+			userList = append(userList, u)
+		}
+
+		resp := map[string]interface{}{
+			"data":     data,
+			"includes": map[string]interface{}{"users": userList},
+		}
+		if end < len(tweets) {
+			resp["meta"] = map[string]interface{}{"next_token": formatPageToken(end)}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}))
+}
+
+func parsePageToken(tok string) (int, error) {
+	var n int
+	err := json.Unmarshal([]byte(tok), &n)
+	return n, err
+}
+
+func formatPageToken(n int) string {
+	b, _ := json.Marshal(n)
+	return string(b)
+}
+
+func TestQueryTweetsFollowsCursorAcrossPages(t *testing.T) {
+	tweets := []pagedTweet{
+		{ID: "1", AuthorID: "u1", Username: "alice", CreatedAt: "2024-01-01T00:00:00Z"},
+		{ID: "2", AuthorID: "u1", Username: "alice", CreatedAt: "2024-01-01T00:01:00Z"},
+		{ID: "3", AuthorID: "u2", Username: "bob", CreatedAt: "2024-01-01T00:02:00Z"},
+		{ID: "4", AuthorID: "u2", Username: "bob", CreatedAt: "2024-01-01T00:03:00Z"},
+		{ID: "5", AuthorID: "u2", Username: "bob", CreatedAt: "2024-01-01T00:04:00Z"},
+	}
+	srv := newPaginatedServer(t, tweets)
+	defer srv.Close()
+
+	c := New("bearer-token")
+	c.searchURL = srv.URL
+
+	posts, err := c.QueryTweets(context.Background(), QueryOptions{TotalCap: 5, MaxResultsPerPage: 2})
+	if err != nil {
+		t.Fatalf("QueryTweets() error = %v", err)
+	}
+
+	if len(posts) != 5 {
+		t.Fatalf("got %d posts, want 5 (across 3 pages of <=2)", len(posts))
+	}
+	for i, p := range posts {
+		wantID := tweets[i].ID
+		if p.ID != wantID {
+			t.Errorf("posts[%d].ID = %q, want %q", i, p.ID, wantID)
+		}
+	}
+	if posts[0].Author != "alice" || posts[2].Author != "bob" {
+		t.Errorf("author_id -> username expansion not applied: %+v", posts)
+	}
+}
+
+func TestQueryTweetsUpdatesNewestIDFromFirstPage(t *testing.T) {
+	tweets := []pagedTweet{
+		{ID: "100", AuthorID: "u1", Username: "alice", CreatedAt: "2024-01-01T00:00:00Z"},
+		{ID: "99", AuthorID: "u1", Username: "alice", CreatedAt: "2024-01-01T00:01:00Z"},
+	}
+	srv := newPaginatedServer(t, tweets)
+	defer srv.Close()
+
+	c := New("bearer-token")
+	c.searchURL = srv.URL
+
+	if _, err := c.QueryTweets(context.Background(), QueryOptions{TotalCap: 2}); err != nil {
+		t.Fatalf("QueryTweets() error = %v", err)
+	}
+
+	if got := c.NewestID(); got != "100" {
+		t.Errorf("NewestID() = %q, want %q (first tweet of the first page)", got, "100")
+	}
+}
+
+func TestQueryTweetsStopsAtTotalCapAcrossPages(t *testing.T) {
+	tweets := []pagedTweet{
+		{ID: "1", AuthorID: "u1", Username: "alice", CreatedAt: "2024-01-01T00:00:00Z"},
+		{ID: "2", AuthorID: "u1", Username: "alice", CreatedAt: "2024-01-01T00:01:00Z"},
+		{ID: "3", AuthorID: "u1", Username: "alice", CreatedAt: "2024-01-01T00:02:00Z"},
+		{ID: "4", AuthorID: "u1", Username: "alice", CreatedAt: "2024-01-01T00:03:00Z"},
+	}
+	srv := newPaginatedServer(t, tweets)
+	defer srv.Close()
+
+	c := New("bearer-token")
+	c.searchURL = srv.URL
+
+	posts, err := c.QueryTweets(context.Background(), QueryOptions{TotalCap: 3, MaxResultsPerPage: 2})
+	if err != nil {
+		t.Fatalf("QueryTweets() error = %v", err)
+	}
+	if len(posts) != 3 {
+		t.Fatalf("got %d posts, want exactly TotalCap=3", len(posts))
+	}
+}
+
+func TestQueryOptionsWithDefaults(t *testing.T) {
+	o := QueryOptions{}.withDefaults()
+	if o.Query != WorkRantQuery {
+		t.Errorf("Query = %q, want WorkRantQuery default", o.Query)
+	}
+	if o.MaxResultsPerPage != 10 {
+		t.Errorf("MaxResultsPerPage = %d, want 10", o.MaxResultsPerPage)
+	}
+	if o.TotalCap != 10 {
+		t.Errorf("TotalCap = %d, want MaxResultsPerPage default of 10", o.TotalCap)
+	}
+
+	o2 := QueryOptions{MaxResultsPerPage: 25}.withDefaults()
+	if o2.TotalCap != 25 {
+		t.Errorf("TotalCap = %d, want it to follow an explicit MaxResultsPerPage of 25", o2.TotalCap)
+	}
+}
@@ -0,0 +1,312 @@
+package twitter
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const twitterAPIBaseURL = "https://api.twitter.com/2"
+
+type postTweetRequest struct {
+	Text string `json:"text"`
+}
+
+type createTweetResponse struct {
+	Data struct {
+		ID string `json:"id"`
+	} `json:"data"`
+}
+
+type likeTweetRequest struct {
+	TweetID string `json:"tweet_id"`
+}
+
+type followRequest struct {
+	TargetUserID string `json:"target_user_id"`
+}
+
+type userLookupResponse struct {
+	Data struct {
+		ID string `json:"id"`
+	} `json:"data"`
+}
+
+// PostTweet publishes text as a new tweet on behalf of the authenticated
+// OAuth1 user, returning the created tweet's ID.
+func (twitterClient *twitterClient) PostTweet(ctx context.Context, text string) (string, error) {
+	payload, err := json.Marshal(postTweetRequest{Text: text})
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal tweet payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", twitterAPIBaseURL+"/tweets", bytes.NewBuffer(payload))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	body, err := twitterClient.doOAuth1(ctx, req)
+	if err != nil {
+		return "", fmt.Errorf("failed to post tweet: %w", err)
+	}
+
+	var parsed createTweetResponse
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return "", fmt.Errorf("failed to decode create tweet response: %w", err)
+	}
+
+	return parsed.Data.ID, nil
+}
+
+// LikeTweet likes the tweet identified by id on behalf of the
+// authenticated OAuth1 user.
+func (twitterClient *twitterClient) LikeTweet(ctx context.Context, id string) error {
+	userID, err := twitterClient.currentUserID(ctx)
+	if err != nil {
+		return err
+	}
+
+	payload, err := json.Marshal(likeTweetRequest{TweetID: id})
+	if err != nil {
+		return fmt.Errorf("failed to marshal like payload: %w", err)
+	}
+
+	likeURL := fmt.Sprintf("%s/users/%s/likes", twitterAPIBaseURL, userID)
+	req, err := http.NewRequestWithContext(ctx, "POST", likeURL, bytes.NewBuffer(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	if _, err := twitterClient.doOAuth1(ctx, req); err != nil {
+		return fmt.Errorf("failed to like tweet %s: %w", id, err)
+	}
+
+	return nil
+}
+
+// FollowUser follows the account identified by username on behalf of the
+// authenticated OAuth1 user.
+func (twitterClient *twitterClient) FollowUser(ctx context.Context, username string) error {
+	userID, err := twitterClient.currentUserID(ctx)
+	if err != nil {
+		return err
+	}
+
+	targetID, err := twitterClient.resolveUsername(ctx, username)
+	if err != nil {
+		return fmt.Errorf("failed to resolve username %q: %w", username, err)
+	}
+
+	payload, err := json.Marshal(followRequest{TargetUserID: targetID})
+	if err != nil {
+		return fmt.Errorf("failed to marshal follow payload: %w", err)
+	}
+
+	followURL := fmt.Sprintf("%s/users/%s/following", twitterAPIBaseURL, userID)
+	req, err := http.NewRequestWithContext(ctx, "POST", followURL, bytes.NewBuffer(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	if _, err := twitterClient.doOAuth1(ctx, req); err != nil {
+		return fmt.Errorf("failed to follow %s: %w", username, err)
+	}
+
+	return nil
+}
+
+// resolveUsername looks up the numeric user ID behind a Twitter handle,
+// required since the v2 follow endpoint addresses its target by ID.
+func (twitterClient *twitterClient) resolveUsername(ctx context.Context, username string) (string, error) {
+	lookupURL := fmt.Sprintf("%s/users/by/username/%s", twitterAPIBaseURL, username)
+	req, err := http.NewRequestWithContext(ctx, "GET", lookupURL, nil)
+	if err != nil {
+		return "", err
+	}
+
+	body, err := twitterClient.doOAuth1(ctx, req)
+	if err != nil {
+		return "", err
+	}
+
+	var parsed userLookupResponse
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return "", fmt.Errorf("failed to decode user lookup response: %w", err)
+	}
+	if parsed.Data.ID == "" {
+		return "", fmt.Errorf("user lookup response missing id")
+	}
+
+	return parsed.Data.ID, nil
+}
+
+// currentUserID resolves and caches the authenticated user's numeric ID,
+// which the v2 like/follow endpoints require in place of the token itself.
+func (twitterClient *twitterClient) currentUserID(ctx context.Context) (string, error) {
+	if twitterClient.userID != "" {
+		return twitterClient.userID, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", twitterAPIBaseURL+"/users/me", nil)
+	if err != nil {
+		return "", err
+	}
+
+	body, err := twitterClient.doOAuth1(ctx, req)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve current user: %w", err)
+	}
+
+	var parsed userLookupResponse
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return "", fmt.Errorf("failed to decode users/me response: %w", err)
+	}
+	if parsed.Data.ID == "" {
+		return "", fmt.Errorf("users/me response missing id")
+	}
+
+	twitterClient.userID = parsed.Data.ID
+	return twitterClient.userID, nil
+}
+
+// doOAuth1 sends req, which the transport signs with the client's OAuth1
+// credentials on every attempt (see NewTwitterOAuth1Client's Sign hook),
+// returning the raw response body on success.
+func (twitterClient *twitterClient) doOAuth1(ctx context.Context, req *http.Request) ([]byte, error) {
+	response, body, info, err := twitterClient.transport.Do(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	twitterClient.lastRateLimit = info
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return nil, fmt.Errorf("unexpected status code %d: %s", response.StatusCode, body)
+	}
+
+	return body, nil
+}
+
+// signRequest signs req per RFC 5849 (OAuth 1.0a, HMAC-SHA1), attaching the
+// resulting Authorization header in the same form as dghubble/oauth1's
+// header signer: oauth_consumer_key, oauth_nonce, oauth_signature_method,
+// oauth_timestamp, oauth_token, oauth_version, and oauth_signature.
+func (twitterClient *twitterClient) signRequest(req *http.Request) error {
+	if twitterClient.consumerKey == "" {
+		return fmt.Errorf("twitter: OAuth1 credentials not configured")
+	}
+
+	nonce, err := generateNonce()
+	if err != nil {
+		return fmt.Errorf("failed to generate OAuth nonce: %w", err)
+	}
+
+	params := map[string]string{
+		"oauth_consumer_key":     twitterClient.consumerKey,
+		"oauth_nonce":            nonce,
+		"oauth_signature_method": "HMAC-SHA1",
+		"oauth_timestamp":        strconv.FormatInt(time.Now().Unix(), 10),
+		"oauth_token":            twitterClient.accessToken,
+		"oauth_version":          "1.0",
+	}
+
+	params["oauth_signature"] = oauthSignature(req.Method, req.URL, params, twitterClient.consumerSecret, twitterClient.accessSecret)
+
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	headerParams := make([]string, 0, len(keys))
+	for _, k := range keys {
+		headerParams = append(headerParams, fmt.Sprintf(`%s="%s"`, percentEncode(k), percentEncode(params[k])))
+	}
+
+	req.Header.Set("Authorization", "OAuth "+strings.Join(headerParams, ", "))
+	return nil
+}
+
+// oauthSignature builds the RFC 5849 signature base string from the
+// request method, URL (query parameters included), and oauth_* parameters,
+// then HMAC-SHA1 signs it with the consumer and token secrets.
+func oauthSignature(method string, u *url.URL, oauthParams map[string]string, consumerSecret, tokenSecret string) string {
+	allParams := make(map[string]string, len(oauthParams)+len(u.Query()))
+	for k, v := range oauthParams {
+		allParams[k] = v
+	}
+	for k, values := range u.Query() {
+		if len(values) > 0 {
+			allParams[k] = values[0]
+		}
+	}
+
+	keys := make([]string, 0, len(allParams))
+	for k := range allParams {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		pairs = append(pairs, percentEncode(k)+"="+percentEncode(allParams[k]))
+	}
+	paramString := strings.Join(pairs, "&")
+
+	baseURL := u.Scheme + "://" + u.Host + u.Path
+	baseString := strings.Join([]string{
+		percentEncode(method),
+		percentEncode(baseURL),
+		percentEncode(paramString),
+	}, "&")
+
+	signingKey := percentEncode(consumerSecret) + "&" + percentEncode(tokenSecret)
+
+	mac := hmac.New(sha1.New, []byte(signingKey))
+	mac.Write([]byte(baseString))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// percentEncode applies RFC 3986 percent-encoding as required by RFC 5849:
+// unreserved characters (A-Z a-z 0-9 - . _ ~) pass through unescaped and
+// everything else, including spaces, is escaped as %XX.
+func percentEncode(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if isUnreservedChar(c) {
+			b.WriteByte(c)
+		} else {
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+func isUnreservedChar(c byte) bool {
+	return (c >= 'A' && c <= 'Z') || (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9') ||
+		c == '-' || c == '.' || c == '_' || c == '~'
+}
+
+// generateNonce returns a random hex string suitable for oauth_nonce.
+func generateNonce() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
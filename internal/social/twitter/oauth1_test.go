@@ -0,0 +1,101 @@
+package twitter
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func newOAuth1TestClient() *twitterClient {
+	return &twitterClient{
+		consumerKey:    "consumer-key",
+		consumerSecret: "consumer-secret",
+		accessToken:    "access-token",
+		accessSecret:   "access-secret",
+	}
+}
+
+func TestSignRequestSetsAuthorizationHeader(t *testing.T) {
+	c := newOAuth1TestClient()
+
+	req, err := http.NewRequest(http.MethodPost, "https://api.twitter.com/2/tweets", nil)
+	if err != nil {
+		t.Fatalf("failed to build request: %v", err)
+	}
+
+	if err := c.signRequest(req); err != nil {
+		t.Fatalf("signRequest() error = %v", err)
+	}
+
+	auth := req.Header.Get("Authorization")
+	if !strings.HasPrefix(auth, "OAuth ") {
+		t.Fatalf("Authorization header = %q, want OAuth prefix", auth)
+	}
+	for _, param := range []string{"oauth_consumer_key", "oauth_nonce", "oauth_signature", "oauth_signature_method=\"HMAC-SHA1\"", "oauth_timestamp", "oauth_token", "oauth_version"} {
+		if !strings.Contains(auth, param) {
+			t.Errorf("Authorization header missing %q: %q", param, auth)
+		}
+	}
+}
+
+func TestSignRequestRequiresCredentials(t *testing.T) {
+	c := &twitterClient{}
+
+	req, err := http.NewRequest(http.MethodPost, "https://api.twitter.com/2/tweets", nil)
+	if err != nil {
+		t.Fatalf("failed to build request: %v", err)
+	}
+
+	if err := c.signRequest(req); err == nil {
+		t.Fatal("signRequest() with no consumer key should return an error")
+	}
+}
+
+// TestSignRequestRefreshesNonceAndTimestamp guards against the
+// retry-replays-the-first-attempt's-signature regression: each call to
+// signRequest (as the httpx.Transport.Sign hook invokes on every retry
+// attempt) must produce a fresh nonce, timestamp, and signature rather than
+// reusing the one computed before the retry loop began.
+func TestSignRequestRefreshesNonceAndTimestamp(t *testing.T) {
+	c := newOAuth1TestClient()
+
+	req1, _ := http.NewRequest(http.MethodPost, "https://api.twitter.com/2/tweets", nil)
+	if err := c.signRequest(req1); err != nil {
+		t.Fatalf("first signRequest() error = %v", err)
+	}
+
+	req2, _ := http.NewRequest(http.MethodPost, "https://api.twitter.com/2/tweets", nil)
+	if err := c.signRequest(req2); err != nil {
+		t.Fatalf("second signRequest() error = %v", err)
+	}
+
+	if req1.Header.Get("Authorization") == req2.Header.Get("Authorization") {
+		t.Error("two signRequest() calls produced an identical Authorization header; nonce/timestamp are not being refreshed per attempt")
+	}
+}
+
+func TestOAuthSignatureIsDeterministicForFixedParams(t *testing.T) {
+	u, err := url.Parse("https://api.twitter.com/2/tweets?foo=bar")
+	if err != nil {
+		t.Fatalf("failed to parse URL: %v", err)
+	}
+
+	params := map[string]string{
+		"oauth_consumer_key":     "consumer-key",
+		"oauth_nonce":            "fixed-nonce",
+		"oauth_signature_method": "HMAC-SHA1",
+		"oauth_timestamp":        "1700000000",
+		"oauth_token":            "access-token",
+		"oauth_version":          "1.0",
+	}
+
+	got := oauthSignature(http.MethodPost, u, params, "consumer-secret", "access-secret")
+	want := oauthSignature(http.MethodPost, u, params, "consumer-secret", "access-secret")
+	if got != want {
+		t.Fatalf("oauthSignature() is not deterministic for identical inputs: %q != %q", got, want)
+	}
+	if got == "" {
+		t.Fatal("oauthSignature() returned an empty signature")
+	}
+}
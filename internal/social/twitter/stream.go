@@ -0,0 +1,363 @@
+package twitter
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/valyala/fastjson"
+)
+
+// WorkRantQuery is the search query shared by QueryWorkRantTweets (polling)
+// and TwitterStreamClient (filtered stream rules), so both ingestion paths
+// watch the same work-rant keyword list.
+const WorkRantQuery = "(work OR job OR boss OR office OR coworker OR meeting OR deadline) (rant OR frustrated OR tired OR hate OR awful OR nightmare) lang:en -is:retweet -filter:videos"
+
+const (
+	twitterStreamURL      = "https://api.twitter.com/2/tweets/search/stream"
+	twitterStreamRulesURL = "https://api.twitter.com/2/tweets/search/stream/rules"
+
+	streamRuleTag = "work-rant"
+
+	// streamReconnectMinDelay and streamReconnectMaxDelay bound the
+	// exponential backoff between reconnect attempts, per Twitter's
+	// guidance for the filtered stream: start at 5s and double up to a
+	// 320s ceiling, resetting after every successful read.
+	streamReconnectMinDelay = 5 * time.Second
+	streamReconnectMaxDelay = 320 * time.Second
+)
+
+// TwitterStreamClient consumes the v2 filtered stream, pushing matching
+// tweets onto Posts() as they arrive so the scheduler can run in push mode
+// instead of polling QueryWorkRantTweets. Modeled after the
+// JustAdam/streamingtwitter demux pattern: a chan Post for successfully
+// parsed tweets and a sibling chan error for connection and parse
+// failures, so a caller can keep consuming posts while logging errors
+// independently.
+type TwitterStreamClient struct {
+	bearerToken string
+	httpClient  *http.Client
+	ParsePool   fastjson.ParserPool
+
+	posts chan Post
+	errs  chan error
+	stop  chan struct{}
+	done  chan struct{}
+}
+
+// NewTwitterStreamClient creates a stream client for the work-rant keyword
+// rule set. Call Start to install the rules and connect, and Stop to shut
+// down cleanly.
+func NewTwitterStreamClient(bearerToken string) *TwitterStreamClient {
+	return &TwitterStreamClient{
+		bearerToken: bearerToken,
+		httpClient:  &http.Client{}, // no timeout: this is a long-lived streaming connection
+		posts:       make(chan Post),
+		errs:        make(chan error),
+		stop:        make(chan struct{}),
+		done:        make(chan struct{}),
+	}
+}
+
+// Posts returns the channel of tweets matched by the active stream rules.
+func (tsc *TwitterStreamClient) Posts() <-chan Post {
+	return tsc.posts
+}
+
+// Errors returns the channel of connection and parse errors encountered
+// while streaming. A send here does not end the stream; reconnects are
+// handled internally with backoff.
+func (tsc *TwitterStreamClient) Errors() <-chan error {
+	return tsc.errs
+}
+
+// Start installs the work-rant rule set and begins consuming the filtered
+// stream in a background goroutine. It reconnects with exponential backoff
+// until Stop is called or ctx is cancelled.
+func (tsc *TwitterStreamClient) Start(ctx context.Context) error {
+	if err := tsc.setRules(ctx); err != nil {
+		return fmt.Errorf("failed to install stream rules: %w", err)
+	}
+
+	go tsc.run(ctx)
+	return nil
+}
+
+// Stop closes the stream and waits for the background goroutine to exit.
+func (tsc *TwitterStreamClient) Stop() {
+	close(tsc.stop)
+	<-tsc.done
+}
+
+func (tsc *TwitterStreamClient) run(ctx context.Context) {
+	defer close(tsc.done)
+
+	delay := streamReconnectMinDelay
+	for {
+		select {
+		case <-tsc.stop:
+			return
+		case <-ctx.Done():
+			return
+		default:
+		}
+
+		readAny, err := tsc.consume(ctx)
+		if readAny {
+			delay = streamReconnectMinDelay
+		}
+		if err != nil {
+			slog.Warn("twitter stream disconnected", "error", err, "reconnect_in", delay)
+			select {
+			case tsc.errs <- err:
+			case <-tsc.stop:
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		select {
+		case <-tsc.stop:
+			return
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+
+		delay *= 2
+		if delay > streamReconnectMaxDelay {
+			delay = streamReconnectMaxDelay
+		}
+	}
+}
+
+// consume opens one connection to the filtered stream and reads
+// newline-delimited JSON tweets from it until the connection drops or Stop
+// is called. The returned bool reports whether at least one tweet was
+// successfully read, which run uses to decide whether to reset the
+// reconnect delay.
+func (tsc *TwitterStreamClient) consume(ctx context.Context) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", twitterStreamURL+"?tweet.fields=created_at,id", nil)
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Authorization", "Bearer "+tsc.bearerToken)
+
+	resp, err := tsc.httpClient.Do(req)
+	if err != nil {
+		return false, fmt.Errorf("stream connection failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return false, fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, body)
+	}
+
+	readAny := false
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	for scanner.Scan() {
+		select {
+		case <-tsc.stop:
+			return readAny, nil
+		case <-ctx.Done():
+			return readAny, ctx.Err()
+		default:
+		}
+
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			// Twitter sends a blank line as a keep-alive heartbeat.
+			continue
+		}
+
+		post, err := tsc.parseStreamTweet(line)
+		if err != nil {
+			if !tsc.emitError(ctx, fmt.Errorf("failed to parse stream tweet: %w", err)) {
+				return readAny, nil
+			}
+			continue
+		}
+
+		readAny = true
+		select {
+		case tsc.posts <- post:
+		case <-tsc.stop:
+			return readAny, nil
+		case <-ctx.Done():
+			return readAny, ctx.Err()
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return readAny, fmt.Errorf("stream read failed: %w", err)
+	}
+
+	return readAny, fmt.Errorf("stream closed by server")
+}
+
+// emitError sends err on the errors channel, returning false if the stream
+// was stopped or ctx cancelled while waiting to send.
+func (tsc *TwitterStreamClient) emitError(ctx context.Context, err error) bool {
+	select {
+	case tsc.errs <- err:
+		return true
+	case <-tsc.stop:
+		return false
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// parseStreamTweet decodes a single newline-delimited JSON line from the
+// filtered stream, which wraps each matched tweet as {"data": {...}}.
+func (tsc *TwitterStreamClient) parseStreamTweet(line []byte) (Post, error) {
+	parser := tsc.ParsePool.Get()
+	defer tsc.ParsePool.Put(parser)
+
+	parsed, err := parser.ParseBytes(line)
+	if err != nil {
+		return Post{}, err
+	}
+
+	data := parsed.Get("data")
+	if data == nil {
+		return Post{}, fmt.Errorf("stream message missing data field")
+	}
+
+	createdAtRaw := string(data.GetStringBytes("created_at"))
+	createdAt, err := time.Parse(time.RFC3339, createdAtRaw)
+	if err != nil {
+		return Post{}, fmt.Errorf("failed to parse created_at %q: %w", createdAtRaw, err)
+	}
+
+	return Post{
+		ID:        string(data.GetStringBytes("id")),
+		Content:   string(data.GetStringBytes("text")),
+		Source:    "Twitter",
+		CreatedAt: createdAt,
+	}, nil
+}
+
+type streamRule struct {
+	Value string `json:"value"`
+	Tag   string `json:"tag"`
+}
+
+type streamRulesResponse struct {
+	Data []struct {
+		ID string `json:"id"`
+	} `json:"data"`
+}
+
+type addStreamRulesRequest struct {
+	Add []streamRule `json:"add"`
+}
+
+type deleteStreamRulesRequest struct {
+	Delete struct {
+		IDs []string `json:"ids"`
+	} `json:"delete"`
+}
+
+// setRules replaces any existing stream rules with a single rule matching
+// WorkRantQuery, so the stream only emits tweets the agent would also have
+// found via QueryWorkRantTweets.
+func (tsc *TwitterStreamClient) setRules(ctx context.Context) error {
+	existingIDs, err := tsc.fetchRuleIDs(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch existing stream rules: %w", err)
+	}
+
+	if len(existingIDs) > 0 {
+		if err := tsc.deleteRules(ctx, existingIDs); err != nil {
+			return fmt.Errorf("failed to delete existing stream rules: %w", err)
+		}
+	}
+
+	return tsc.addRule(ctx)
+}
+
+func (tsc *TwitterStreamClient) fetchRuleIDs(ctx context.Context) ([]string, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", twitterStreamRulesURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+tsc.bearerToken)
+
+	resp, err := tsc.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, body)
+	}
+
+	var parsed streamRulesResponse
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return nil, fmt.Errorf("failed to decode rules response: %w", err)
+	}
+
+	ids := make([]string, len(parsed.Data))
+	for i, rule := range parsed.Data {
+		ids[i] = rule.ID
+	}
+	return ids, nil
+}
+
+func (tsc *TwitterStreamClient) deleteRules(ctx context.Context, ids []string) error {
+	var payload deleteStreamRulesRequest
+	payload.Delete.IDs = ids
+
+	return tsc.putRules(ctx, payload)
+}
+
+func (tsc *TwitterStreamClient) addRule(ctx context.Context) error {
+	payload := addStreamRulesRequest{
+		Add: []streamRule{{Value: WorkRantQuery, Tag: streamRuleTag}},
+	}
+
+	return tsc.putRules(ctx, payload)
+}
+
+func (tsc *TwitterStreamClient) putRules(ctx context.Context, payload interface{}) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal rules payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", twitterStreamRulesURL, bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+tsc.bearerToken)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := tsc.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, respBody)
+	}
+
+	return nil
+}
@@ -0,0 +1,157 @@
+// Package store provides a persistent, TTL-based record of source posts the
+// agent has already consumed, so the same viral rant can't drive repeated
+// near-identical generations across polling cycles. It is deliberately
+// separate from internal/ledger, which audits dispatched posts by content
+// hash rather than tracking source freshness with an expiry.
+package store
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	seenBucket   = "seen"
+	postedBucket = "posted"
+
+	// DefaultTTL is the expiry applied when Mark is called with ttl <= 0.
+	DefaultTTL = 30 * 24 * time.Hour
+)
+
+// SeenStore tracks which source posts have already been consumed, letting
+// content sources filter them out before they ever reach the generator.
+type SeenStore interface {
+	// Seen reports whether sourceID was marked and its TTL hasn't expired.
+	Seen(sourceID string) bool
+	// Mark records sourceID as consumed for ttl. A ttl <= 0 uses DefaultTTL.
+	Mark(sourceID string, ttl time.Duration)
+	// MarkPosted records that sourceID was published to targetURI, for
+	// later audit/rollback.
+	MarkPosted(sourceID, targetURI string)
+}
+
+type seenEntry struct {
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+type postedEntry struct {
+	TargetURI string    `json:"target_uri"`
+	PostedAt  time.Time `json:"posted_at"`
+}
+
+// Store is a bbolt-backed SeenStore.
+type Store struct {
+	db *bolt.DB
+}
+
+var _ SeenStore = (*Store)(nil)
+
+// Open opens (creating if necessary) a seen-post store at path.
+func Open(path string) (*Store, error) {
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open store at %s: %w", path, err)
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		for _, bucket := range []string{seenBucket, postedBucket} {
+			if _, err := tx.CreateBucketIfNotExists([]byte(bucket)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to initialize store buckets: %w", err)
+	}
+
+	return &Store{db: db}, nil
+}
+
+// Close releases the underlying store file.
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// Seen reports whether sourceID was marked and its TTL hasn't expired.
+func (s *Store) Seen(sourceID string) bool {
+	var seen bool
+	_ = s.db.View(func(tx *bolt.Tx) error {
+		raw := tx.Bucket([]byte(seenBucket)).Get([]byte(sourceID))
+		if raw == nil {
+			return nil
+		}
+		var entry seenEntry
+		if err := json.Unmarshal(raw, &entry); err != nil {
+			return nil
+		}
+		seen = time.Now().Before(entry.ExpiresAt)
+		return nil
+	})
+	return seen
+}
+
+// Mark records sourceID as consumed for ttl. A ttl <= 0 uses DefaultTTL.
+func (s *Store) Mark(sourceID string, ttl time.Duration) {
+	if ttl <= 0 {
+		ttl = DefaultTTL
+	}
+
+	value, err := json.Marshal(seenEntry{ExpiresAt: time.Now().Add(ttl)})
+	if err != nil {
+		return
+	}
+
+	_ = s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(seenBucket)).Put([]byte(sourceID), value)
+	})
+}
+
+// MarkPosted records that sourceID was published to targetURI, for later
+// audit/rollback.
+func (s *Store) MarkPosted(sourceID, targetURI string) {
+	value, err := json.Marshal(postedEntry{TargetURI: targetURI, PostedAt: time.Now()})
+	if err != nil {
+		return
+	}
+
+	_ = s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(postedBucket)).Put([]byte(sourceID), value)
+	})
+}
+
+// Vacuum sweeps every expired seen entry, returning the number removed.
+func (s *Store) Vacuum() (int, error) {
+	removed := 0
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte(seenBucket))
+		c := bucket.Cursor()
+
+		var expired [][]byte
+		now := time.Now()
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			var entry seenEntry
+			if err := json.Unmarshal(v, &entry); err != nil {
+				continue
+			}
+			if now.After(entry.ExpiresAt) {
+				expired = append(expired, append([]byte(nil), k...))
+			}
+		}
+
+		for _, key := range expired {
+			if err := bucket.Delete(key); err != nil {
+				return err
+			}
+			removed++
+		}
+
+		return nil
+	})
+
+	return removed, err
+}
@@ -2,19 +2,68 @@ package internal
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
+	"math"
+	"math/rand"
 	"net/http"
+	"strconv"
 	"time"
+
+	"golang.org/x/time/rate"
 )
 
+// ThreadsClientOptions configures the concurrency and rate limits applied
+// to every ThreadsClient call, so a scheduler burst (e.g. LikePostsPerDay
+// set high) can't hammer the Graph API past its throttling thresholds. A
+// zero value field falls back to its corresponding default.
+type ThreadsClientOptions struct {
+	// MaxConcurrentRequests caps how many ThreadsClient calls may be in
+	// flight at once, across every endpoint. Default 5.
+	MaxConcurrentRequests int
+	// RequestsPerMinute is the default per-endpoint rate limit, used by any
+	// endpoint whose own *RPM field below is left at 0.
+	RequestsPerMinute int
+	CreatePostRPM     int
+	LikePostRPM       int
+	FollowUserRPM     int
+}
+
+func (o ThreadsClientOptions) withDefaults() ThreadsClientOptions {
+	if o.MaxConcurrentRequests <= 0 {
+		o.MaxConcurrentRequests = 5
+	}
+	if o.RequestsPerMinute <= 0 {
+		o.RequestsPerMinute = 60
+	}
+	if o.CreatePostRPM <= 0 {
+		o.CreatePostRPM = o.RequestsPerMinute
+	}
+	if o.LikePostRPM <= 0 {
+		o.LikePostRPM = o.RequestsPerMinute
+	}
+	if o.FollowUserRPM <= 0 {
+		o.FollowUserRPM = o.RequestsPerMinute
+	}
+	return o
+}
+
 // ThreadsClient interacts with the Threads Graph API.
 type ThreadsClient struct {
-	baseURL       string
-	accessToken   string
-	httpClient    *http.Client
-	businessID    string
+	baseURL     string
+	accessToken string
+	httpClient  *http.Client
+	businessID  string
+
+	// sem bounds total in-flight requests across every endpoint.
+	sem chan struct{}
+
+	createLimiter *rate.Limiter
+	likeLimiter   *rate.Limiter
+	followLimiter *rate.Limiter
+	readLimiter   *rate.Limiter
 }
 
 type postRequest struct {
@@ -37,8 +86,16 @@ type timelineResponse struct {
 	} `json:"data"`
 }
 
-// NewThreadsClient creates a new Threads API client.
-func NewThreadsClient(accessToken, businessID string) *ThreadsClient {
+// maxRetries is how many times a request is retried after a 429 or 5xx
+// response before giving up.
+const maxRetries = 4
+
+// NewThreadsClient creates a new Threads API client. opts configures its
+// concurrency semaphore and per-endpoint rate limiters; the zero value of
+// ThreadsClientOptions uses sane defaults (see withDefaults).
+func NewThreadsClient(accessToken, businessID string, opts ThreadsClientOptions) *ThreadsClient {
+	opts = opts.withDefaults()
+
 	return &ThreadsClient{
 		baseURL:     "https://graph.threads.net/v1",
 		accessToken: accessToken,
@@ -46,11 +103,119 @@ func NewThreadsClient(accessToken, businessID string) *ThreadsClient {
 		httpClient: &http.Client{
 			Timeout: 30 * time.Second,
 		},
+		sem:           make(chan struct{}, opts.MaxConcurrentRequests),
+		createLimiter: rpmLimiter(opts.CreatePostRPM),
+		likeLimiter:   rpmLimiter(opts.LikePostRPM),
+		followLimiter: rpmLimiter(opts.FollowUserRPM),
+		readLimiter:   rpmLimiter(opts.RequestsPerMinute),
+	}
+}
+
+// rpmLimiter builds a token-bucket limiter that admits rpm requests per
+// minute, with a burst of 1 so it paces requests rather than letting a full
+// minute's quota fire at once.
+func rpmLimiter(rpm int) *rate.Limiter {
+	return rate.NewLimiter(rate.Limit(float64(rpm)/60.0), 1)
+}
+
+// acquire blocks until a request slot is free and the given limiter admits
+// the next request, then returns a func to release the slot.
+func (tc *ThreadsClient) acquire(ctx context.Context, limiter *rate.Limiter) (func(), error) {
+	select {
+	case tc.sem <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	if err := limiter.Wait(ctx); err != nil {
+		<-tc.sem
+		return nil, err
+	}
+
+	return func() { <-tc.sem }, nil
+}
+
+// do sends req, retrying with exponential backoff (honoring any Retry-After
+// header) on HTTP 429 and 5xx responses, up to maxRetries times.
+func (tc *ThreadsClient) do(ctx context.Context, req *http.Request) (*http.Response, []byte, error) {
+	var lastResp *http.Response
+	var lastBody []byte
+
+	for attempt := 0; attempt <= maxRetries; attempt++ {
+		if attempt > 0 {
+			delay := retryDelay(attempt, lastResp)
+			select {
+			case <-time.After(delay):
+			case <-ctx.Done():
+				return nil, nil, ctx.Err()
+			}
+
+			if req.GetBody != nil {
+				rewound, err := req.GetBody()
+				if err != nil {
+					return nil, nil, fmt.Errorf("failed to rewind request body: %w", err)
+				}
+				req.Body = rewound
+			}
+		}
+
+		resp, err := tc.httpClient.Do(req.WithContext(ctx))
+		if err != nil {
+			return nil, nil, fmt.Errorf("request failed: %w", err)
+		}
+
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read response: %w", err)
+		}
+
+		if !shouldRetry(resp.StatusCode) {
+			return resp, body, nil
+		}
+
+		lastResp, lastBody = resp, body
+	}
+
+	return lastResp, lastBody, nil
+}
+
+func shouldRetry(statusCode int) bool {
+	return statusCode == http.StatusTooManyRequests || statusCode >= 500
+}
+
+// retryDelay computes the backoff before attempt N, preferring the
+// server's Retry-After header (seconds or HTTP-date) over our own
+// exponential schedule when one is present on the previous response.
+func retryDelay(attempt int, prevResp *http.Response) time.Duration {
+	if prevResp != nil {
+		if retryAfter := prevResp.Header.Get("Retry-After"); retryAfter != "" {
+			if seconds, err := strconv.Atoi(retryAfter); err == nil {
+				return time.Duration(seconds) * time.Second
+			}
+			if when, err := http.ParseTime(retryAfter); err == nil {
+				if d := time.Until(when); d > 0 {
+					return d
+				}
+			}
+		}
 	}
+
+	base := time.Duration(math.Pow(2, float64(attempt))) * 500 * time.Millisecond
+	jitter := time.Duration(rand.Int63n(int64(base) / 2))
+	return base + jitter
 }
 
 // CreatePost creates a new post on Threads.
 func (tc *ThreadsClient) CreatePost(text string) (string, error) {
+	ctx := context.Background()
+
+	release, err := tc.acquire(ctx, tc.createLimiter)
+	if err != nil {
+		return "", fmt.Errorf("failed to acquire request slot: %w", err)
+	}
+	defer release()
+
 	url := fmt.Sprintf("%s/%s/threads", tc.baseURL, tc.businessID)
 
 	payload := postRequest{
@@ -71,15 +236,9 @@ func (tc *ThreadsClient) CreatePost(text string) (string, error) {
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", tc.accessToken))
 
-	resp, err := tc.httpClient.Do(req)
-	if err != nil {
-		return "", fmt.Errorf("request failed: %w", err)
-	}
-	defer resp.Body.Close()
-
-	body, err := io.ReadAll(resp.Body)
+	resp, body, err := tc.do(ctx, req)
 	if err != nil {
-		return "", fmt.Errorf("failed to read response: %w", err)
+		return "", err
 	}
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
@@ -96,6 +255,14 @@ func (tc *ThreadsClient) CreatePost(text string) (string, error) {
 
 // FollowUser follows a user by ID.
 func (tc *ThreadsClient) FollowUser(userID string) error {
+	ctx := context.Background()
+
+	release, err := tc.acquire(ctx, tc.followLimiter)
+	if err != nil {
+		return fmt.Errorf("failed to acquire request slot: %w", err)
+	}
+	defer release()
+
 	url := fmt.Sprintf("%s/%s/follows", tc.baseURL, userID)
 
 	req, err := http.NewRequest("POST", url, nil)
@@ -105,15 +272,9 @@ func (tc *ThreadsClient) FollowUser(userID string) error {
 
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", tc.accessToken))
 
-	resp, err := tc.httpClient.Do(req)
-	if err != nil {
-		return fmt.Errorf("request failed: %w", err)
-	}
-	defer resp.Body.Close()
-
-	body, err := io.ReadAll(resp.Body)
+	resp, body, err := tc.do(ctx, req)
 	if err != nil {
-		return fmt.Errorf("failed to read response: %w", err)
+		return err
 	}
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
@@ -125,6 +286,14 @@ func (tc *ThreadsClient) FollowUser(userID string) error {
 
 // LikePost likes a post.
 func (tc *ThreadsClient) LikePost(postID string) error {
+	ctx := context.Background()
+
+	release, err := tc.acquire(ctx, tc.likeLimiter)
+	if err != nil {
+		return fmt.Errorf("failed to acquire request slot: %w", err)
+	}
+	defer release()
+
 	url := fmt.Sprintf("%s/%s/likes", tc.baseURL, tc.businessID)
 
 	payload := likeRequest{
@@ -144,15 +313,9 @@ func (tc *ThreadsClient) LikePost(postID string) error {
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", tc.accessToken))
 
-	resp, err := tc.httpClient.Do(req)
-	if err != nil {
-		return fmt.Errorf("request failed: %w", err)
-	}
-	defer resp.Body.Close()
-
-	body, err := io.ReadAll(resp.Body)
+	resp, body, err := tc.do(ctx, req)
 	if err != nil {
-		return fmt.Errorf("failed to read response: %w", err)
+		return err
 	}
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
@@ -164,6 +327,14 @@ func (tc *ThreadsClient) LikePost(postID string) error {
 
 // GetRecentPosts fetches recent posts from the timeline.
 func (tc *ThreadsClient) GetRecentPosts(limit int) ([]string, error) {
+	ctx := context.Background()
+
+	release, err := tc.acquire(ctx, tc.readLimiter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to acquire request slot: %w", err)
+	}
+	defer release()
+
 	url := fmt.Sprintf("%s/%s/threads_feed?fields=id,text&limit=%d", tc.baseURL, tc.businessID, limit)
 
 	req, err := http.NewRequest("GET", url, nil)
@@ -173,15 +344,9 @@ func (tc *ThreadsClient) GetRecentPosts(limit int) ([]string, error) {
 
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", tc.accessToken))
 
-	resp, err := tc.httpClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("request failed: %w", err)
-	}
-	defer resp.Body.Close()
-
-	body, err := io.ReadAll(resp.Body)
+	resp, body, err := tc.do(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response: %w", err)
+		return nil, err
 	}
 
 	if resp.StatusCode != http.StatusOK {
@@ -0,0 +1,43 @@
+package internal
+
+import (
+	"context"
+
+	"social-agent/internal/social"
+)
+
+// ThreadsPoster adapts the legacy no-context ThreadsClient to the
+// context-aware social.Poster contract internal/scheduler.Scheduler fans
+// generated posts out through, so Threads can be registered alongside the
+// context-aware Bluesky/Mastodon/Twitter clients. The wrapped calls ignore
+// the passed context since ThreadsClient has no way to accept one.
+type ThreadsPoster struct {
+	client *ThreadsClient
+}
+
+var _ social.Poster = (*ThreadsPoster)(nil)
+
+// NewThreadsPoster wraps client as a social.Poster.
+func NewThreadsPoster(client *ThreadsClient) *ThreadsPoster {
+	return &ThreadsPoster{client: client}
+}
+
+// CreatePost creates a new post on Threads.
+func (tp *ThreadsPoster) CreatePost(ctx context.Context, text string) (string, error) {
+	return tp.client.CreatePost(text)
+}
+
+// FollowUser follows a user on Threads.
+func (tp *ThreadsPoster) FollowUser(ctx context.Context, userHandle string) error {
+	return tp.client.FollowUser(userHandle)
+}
+
+// LikePost likes a post on Threads.
+func (tp *ThreadsPoster) LikePost(ctx context.Context, postID string) error {
+	return tp.client.LikePost(postID)
+}
+
+// GetRecentPosts fetches recent posts from the user's feed.
+func (tp *ThreadsPoster) GetRecentPosts(ctx context.Context, limit int) ([]string, error) {
+	return tp.client.GetRecentPosts(limit)
+}
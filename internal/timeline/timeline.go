@@ -0,0 +1,347 @@
+// Package timeline materializes every stage of a source post's life --
+// first seen as a candidate, generated into content, and (if posted)
+// published to a destination -- into one append-only, time-ordered feed.
+// It exists alongside internal/ledger rather than replacing it: the ledger
+// is a forever-lived audit log of dispatched posts, while Timeline answers
+// a question the ledger can't -- "has this exact text gone out in the last
+// N days," not "ever" -- and gives an eventual admin UI something to
+// render via Recent.
+package timeline
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	bolt "go.etcd.io/bbolt"
+)
+
+// Stage identifies which point in a source post's life an Entry records.
+type Stage string
+
+const (
+	// StageSeen marks a source post as fetched and considered as a
+	// candidate, before any generation happens.
+	StageSeen Stage = "seen"
+	// StageGenerated marks that content was generated from a source post.
+	StageGenerated Stage = "generated"
+	// StagePosted marks that generated content was successfully dispatched
+	// to a platform.
+	StagePosted Stage = "posted"
+)
+
+// Entry is a single recorded timeline event.
+type Entry struct {
+	ID          string    `json:"id"`
+	Stage       Stage     `json:"stage"`
+	SourceID    string    `json:"source_id"`
+	SourceURL   string    `json:"source_url,omitempty"`
+	Platform    string    `json:"platform,omitempty"`
+	PostID      string    `json:"post_id,omitempty"`
+	ContentHash string    `json:"content_hash,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+const (
+	entriesBucket = "entries"
+	hashBucket    = "content_hashes"
+)
+
+// Timeline is a bbolt-backed, append-only feed of timeline Entries.
+type Timeline struct {
+	db        *bolt.DB
+	retention time.Duration
+}
+
+// Open opens (creating if necessary) a timeline file at path. retention is
+// the window StartCompaction trims entries older than; a retention <= 0
+// disables compaction.
+func Open(path string, retention time.Duration) (*Timeline, error) {
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open timeline at %s: %w", path, err)
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		for _, bucket := range []string{entriesBucket, hashBucket} {
+			if _, err := tx.CreateBucketIfNotExists([]byte(bucket)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to initialize timeline buckets: %w", err)
+	}
+
+	return &Timeline{db: db, retention: retention}, nil
+}
+
+// Close releases the underlying timeline file.
+func (t *Timeline) Close() error {
+	return t.db.Close()
+}
+
+// HashContent returns the stable SHA-256 hex digest of content's
+// normalized text (case-folded, whitespace-collapsed), so near-identical
+// Gemini output with incidental formatting differences still collides.
+func HashContent(content string) string {
+	sum := sha256.Sum256([]byte(normalize(content)))
+	return hex.EncodeToString(sum[:])
+}
+
+func normalize(content string) string {
+	return strings.ToLower(strings.Join(strings.Fields(content), " "))
+}
+
+// RecordSeen appends a StageSeen entry for a freshly-fetched candidate
+// source post, so Recent reflects the full candidate pool the scheduler
+// considered, not just what it eventually posted.
+func (t *Timeline) RecordSeen(sourceID, sourceURL string) error {
+	_, err := t.record(Entry{Stage: StageSeen, SourceID: sourceID, SourceURL: sourceURL})
+	return err
+}
+
+// RecordGenerated appends a StageGenerated entry and indexes content's
+// hash, so a later RecentCollision call can find it.
+func (t *Timeline) RecordGenerated(sourceID, content string) (Entry, error) {
+	return t.record(Entry{Stage: StageGenerated, SourceID: sourceID, ContentHash: HashContent(content)})
+}
+
+// RecordPosted appends a StagePosted entry once content was successfully
+// dispatched to platform as postID.
+func (t *Timeline) RecordPosted(sourceID, platform, postID, content string) (Entry, error) {
+	return t.record(Entry{
+		Stage:       StagePosted,
+		SourceID:    sourceID,
+		Platform:    platform,
+		PostID:      postID,
+		ContentHash: HashContent(content),
+	})
+}
+
+func (t *Timeline) record(entry Entry) (Entry, error) {
+	id := ulid.MustNew(ulid.Timestamp(time.Now()), rand.Reader)
+	entry.ID = id.String()
+	entry.CreatedAt = time.Now().UTC()
+
+	value, err := json.Marshal(entry)
+	if err != nil {
+		return Entry{}, fmt.Errorf("failed to marshal timeline entry: %w", err)
+	}
+
+	err = t.db.Update(func(tx *bolt.Tx) error {
+		if err := tx.Bucket([]byte(entriesBucket)).Put(id[:], value); err != nil {
+			return err
+		}
+		if entry.ContentHash == "" {
+			return nil
+		}
+		return appendHashIndex(tx, entry.ContentHash, id)
+	})
+	if err != nil {
+		return Entry{}, fmt.Errorf("failed to write timeline entry: %w", err)
+	}
+
+	return entry, nil
+}
+
+func appendHashIndex(tx *bolt.Tx, hash string, id ulid.ULID) error {
+	bucket := tx.Bucket([]byte(hashBucket))
+	var ids []string
+	if raw := bucket.Get([]byte(hash)); raw != nil {
+		if err := json.Unmarshal(raw, &ids); err != nil {
+			return err
+		}
+	}
+	ids = append(ids, id.String())
+
+	value, err := json.Marshal(ids)
+	if err != nil {
+		return err
+	}
+	return bucket.Put([]byte(hash), value)
+}
+
+// RecentCollision reports whether content's normalized hash was recorded
+// (at StageGenerated or StagePosted) within the last window, so the
+// scheduler can reject regenerating or reposting near-identical output
+// without waiting on the ledger's forever-lived dedupe.
+func (t *Timeline) RecentCollision(content string, window time.Duration) (bool, error) {
+	hash := HashContent(content)
+	cutoff := time.Now().Add(-window)
+
+	var collided bool
+	err := t.db.View(func(tx *bolt.Tx) error {
+		raw := tx.Bucket([]byte(hashBucket)).Get([]byte(hash))
+		if raw == nil {
+			return nil
+		}
+
+		var ids []string
+		if err := json.Unmarshal(raw, &ids); err != nil {
+			return err
+		}
+
+		entries := tx.Bucket([]byte(entriesBucket))
+		for _, idStr := range ids {
+			id, err := ulid.Parse(idStr)
+			if err != nil {
+				continue
+			}
+			entryRaw := entries.Get(id[:])
+			if entryRaw == nil {
+				continue
+			}
+			var entry Entry
+			if err := json.Unmarshal(entryRaw, &entry); err != nil {
+				continue
+			}
+			if entry.CreatedAt.After(cutoff) {
+				collided = true
+				return nil
+			}
+		}
+		return nil
+	})
+
+	return collided, err
+}
+
+// Recent returns the limit most recently recorded entries, newest first.
+func (t *Timeline) Recent(ctx context.Context, limit int) ([]Entry, error) {
+	var entries []Entry
+	err := t.db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket([]byte(entriesBucket)).Cursor()
+		for k, v := c.Last(); k != nil && len(entries) < limit; k, v = c.Prev() {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			default:
+			}
+
+			var entry Entry
+			if err := json.Unmarshal(v, &entry); err != nil {
+				return err
+			}
+			entries = append(entries, entry)
+		}
+		return nil
+	})
+
+	return entries, err
+}
+
+// StartCompaction runs Compact every interval until ctx is done. A
+// Timeline opened with retention <= 0 never compacts.
+func (t *Timeline) StartCompaction(ctx context.Context, interval time.Duration) {
+	if t.retention <= 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				removed, err := t.Compact()
+				if err != nil {
+					slog.Error("timeline compaction failed", "error", err)
+					continue
+				}
+				if removed > 0 {
+					slog.Info("timeline compaction removed stale entries", "removed", removed)
+				}
+			}
+		}
+	}()
+}
+
+// Compact removes every entry (and its hash index reference) older than
+// the Timeline's retention window. A retention <= 0 is a no-op.
+func (t *Timeline) Compact() (int, error) {
+	if t.retention <= 0 {
+		return 0, nil
+	}
+
+	cutoff := ulid.MustNew(ulid.Timestamp(time.Now().Add(-t.retention)), zeroEntropy{})
+
+	removed := 0
+	err := t.db.Update(func(tx *bolt.Tx) error {
+		entries := tx.Bucket([]byte(entriesBucket))
+		c := entries.Cursor()
+
+		var stale [][]byte
+		for k, _ := c.First(); k != nil && string(k) < string(cutoff[:]); k, _ = c.Next() {
+			stale = append(stale, append([]byte(nil), k...))
+		}
+
+		hashes := tx.Bucket([]byte(hashBucket))
+		for _, key := range stale {
+			if err := entries.Delete(key); err != nil {
+				return err
+			}
+			removed++
+		}
+
+		// Prune the hash index of references to whatever was just
+		// removed, so it doesn't grow unbounded.
+		c2 := hashes.Cursor()
+		for hk, hv := c2.First(); hk != nil; hk, hv = c2.Next() {
+			var ids []string
+			if err := json.Unmarshal(hv, &ids); err != nil {
+				continue
+			}
+			kept := ids[:0]
+			for _, idStr := range ids {
+				id, err := ulid.Parse(idStr)
+				if err != nil {
+					continue
+				}
+				if entries.Get(id[:]) != nil {
+					kept = append(kept, idStr)
+				}
+			}
+			if len(kept) == 0 {
+				if err := hashes.Delete(hk); err != nil {
+					return err
+				}
+				continue
+			}
+			value, err := json.Marshal(kept)
+			if err != nil {
+				return err
+			}
+			if err := hashes.Put(hk, value); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	return removed, err
+}
+
+// zeroEntropy produces all-zero entropy bytes, used when building a ULID
+// purely for its timestamp-ordered key (the Compact cutoff), where the
+// random component doesn't matter.
+type zeroEntropy struct{}
+
+func (zeroEntropy) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = 0
+	}
+	return len(p), nil
+}
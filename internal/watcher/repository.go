@@ -0,0 +1,176 @@
+package watcher
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+)
+
+// Repository persists Watcher rules, keyed by ID.
+type Repository interface {
+	Add(w Watcher) error
+	Update(w Watcher) error
+	Remove(id string) error
+	List() ([]Watcher, error)
+}
+
+// MemoryRepository is an in-process Repository with no persistence,
+// suitable for tests and for running without a configured watchers file.
+type MemoryRepository struct {
+	mu       sync.Mutex
+	watchers map[string]Watcher
+}
+
+var _ Repository = (*MemoryRepository)(nil)
+
+// NewMemoryRepository returns an empty in-memory Repository.
+func NewMemoryRepository() *MemoryRepository {
+	return &MemoryRepository{watchers: make(map[string]Watcher)}
+}
+
+func (r *MemoryRepository) Add(w Watcher) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.watchers[w.ID] = w
+	return nil
+}
+
+func (r *MemoryRepository) Update(w Watcher) error {
+	return r.Add(w)
+}
+
+func (r *MemoryRepository) Remove(id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.watchers, id)
+	return nil
+}
+
+func (r *MemoryRepository) List() ([]Watcher, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	watchers := make([]Watcher, 0, len(r.watchers))
+	for _, w := range r.watchers {
+		watchers = append(watchers, w)
+	}
+	return watchers, nil
+}
+
+// FileRepository is a Repository backed by a single JSON file holding the
+// full watcher list, read and rewritten whole on every mutation. This
+// mirrors how few watchers a deployment is expected to run (tens, not
+// thousands), so a bbolt-backed store like ledger/store would be overkill.
+type FileRepository struct {
+	path string
+	mu   sync.Mutex
+}
+
+var _ Repository = (*FileRepository)(nil)
+
+// NewFileRepository returns a Repository backed by the JSON file at path,
+// creating it with an empty watcher list if it doesn't already exist.
+func NewFileRepository(path string) (*FileRepository, error) {
+	fr := &FileRepository{path: path}
+
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		if err := fr.writeAll(nil); err != nil {
+			return nil, fmt.Errorf("failed to initialize watchers file: %w", err)
+		}
+	}
+
+	return fr, nil
+}
+
+func (fr *FileRepository) Add(w Watcher) error {
+	fr.mu.Lock()
+	defer fr.mu.Unlock()
+
+	watchers, err := fr.readAll()
+	if err != nil {
+		return err
+	}
+
+	watchers = append(watchers, w)
+	return fr.writeAll(watchers)
+}
+
+func (fr *FileRepository) Update(w Watcher) error {
+	fr.mu.Lock()
+	defer fr.mu.Unlock()
+
+	watchers, err := fr.readAll()
+	if err != nil {
+		return err
+	}
+
+	found := false
+	for i := range watchers {
+		if watchers[i].ID == w.ID {
+			watchers[i] = w
+			found = true
+			break
+		}
+	}
+	if !found {
+		watchers = append(watchers, w)
+	}
+
+	return fr.writeAll(watchers)
+}
+
+func (fr *FileRepository) Remove(id string) error {
+	fr.mu.Lock()
+	defer fr.mu.Unlock()
+
+	watchers, err := fr.readAll()
+	if err != nil {
+		return err
+	}
+
+	kept := watchers[:0]
+	for _, w := range watchers {
+		if w.ID != id {
+			kept = append(kept, w)
+		}
+	}
+
+	return fr.writeAll(kept)
+}
+
+func (fr *FileRepository) List() ([]Watcher, error) {
+	fr.mu.Lock()
+	defer fr.mu.Unlock()
+	return fr.readAll()
+}
+
+func (fr *FileRepository) readAll() ([]Watcher, error) {
+	data, err := os.ReadFile(fr.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read watchers file: %w", err)
+	}
+
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	var watchers []Watcher
+	if err := json.Unmarshal(data, &watchers); err != nil {
+		return nil, fmt.Errorf("failed to parse watchers file: %w", err)
+	}
+
+	return watchers, nil
+}
+
+func (fr *FileRepository) writeAll(watchers []Watcher) error {
+	data, err := json.MarshalIndent(watchers, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal watchers: %w", err)
+	}
+
+	if err := os.WriteFile(fr.path, data, 0600); err != nil {
+		return fmt.Errorf("failed to write watchers file: %w", err)
+	}
+
+	return nil
+}
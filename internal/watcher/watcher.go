@@ -0,0 +1,98 @@
+// Package watcher implements apollo-backend-style watch rules that target
+// engagement (follow/like) at specific Reddit content instead of the
+// uniform-random candidate picked by Scheduler.postRoutine. A Watcher
+// describes the shape of content worth engaging with; Scheduler evaluates
+// the enabled Watchers against each fetched reddit.Post in order and acts
+// on the first one that matches.
+package watcher
+
+import (
+	"strings"
+
+	"social-agent/internal/social/reddit"
+)
+
+// Action selects which engagement routine a matching post should feed.
+type Action string
+
+const (
+	ActionFollow Action = "follow"
+	ActionLike   Action = "like"
+	ActionBoth   Action = "both"
+)
+
+// Watcher is a single targeting rule: a post must satisfy every non-zero
+// criterion to match. Keywords match if any one of them appears in the
+// post's title or body (case-insensitive); AuthorAllowlist, if non-empty,
+// restricts matches to listed authors, while AuthorBlocklist excludes them
+// regardless of any other criterion.
+type Watcher struct {
+	ID              string   `json:"id"`
+	Label           string   `json:"label"`
+	Subreddit       string   `json:"subreddit"`
+	MinUpvotes      int      `json:"min_upvotes"`
+	Keywords        []string `json:"keywords"`
+	Flair           string   `json:"flair"`
+	AuthorAllowlist []string `json:"author_allowlist"`
+	AuthorBlocklist []string `json:"author_blocklist"`
+	Action          Action   `json:"action"`
+	Enabled         bool     `json:"enabled"`
+}
+
+// Matches reports whether post satisfies every criterion set on w.
+func (w Watcher) Matches(post *reddit.Post) bool {
+	if containsFold(w.AuthorBlocklist, post.Author) {
+		return false
+	}
+
+	if len(w.AuthorAllowlist) > 0 && !containsFold(w.AuthorAllowlist, post.Author) {
+		return false
+	}
+
+	if w.Subreddit != "" && !strings.EqualFold(w.Subreddit, post.Subreddit) {
+		return false
+	}
+
+	if w.MinUpvotes > 0 && post.Upvotes < w.MinUpvotes {
+		return false
+	}
+
+	if w.Flair != "" && !strings.EqualFold(w.Flair, post.Flair) {
+		return false
+	}
+
+	if len(w.Keywords) > 0 {
+		haystack := strings.ToLower(post.Title + " " + post.Content)
+		matched := false
+		for _, keyword := range w.Keywords {
+			if strings.Contains(haystack, strings.ToLower(keyword)) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+
+	return true
+}
+
+// WantsFollow reports whether a match on w should drive followRoutine.
+func (w Watcher) WantsFollow() bool {
+	return w.Action == ActionFollow || w.Action == ActionBoth
+}
+
+// WantsLike reports whether a match on w should drive likeRoutine.
+func (w Watcher) WantsLike() bool {
+	return w.Action == ActionLike || w.Action == ActionBoth
+}
+
+func containsFold(list []string, s string) bool {
+	for _, item := range list {
+		if strings.EqualFold(item, s) {
+			return true
+		}
+	}
+	return false
+}